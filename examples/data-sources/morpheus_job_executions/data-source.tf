@@ -0,0 +1,12 @@
+data "morpheus_job_executions" "nightly" {
+  job_id        = morpheus_workflow_job.tf_example_workflow_job.id
+  started_after = "2024-01-01T00:00:00Z"
+  max_results   = 1
+}
+
+check "nightly_workflow_succeeded" {
+  assert {
+    condition     = data.morpheus_job_executions.nightly.executions[0].status == "complete"
+    error_message = "The last nightly workflow execution failed: ${data.morpheus_job_executions.nightly.executions[0].error}"
+  }
+}
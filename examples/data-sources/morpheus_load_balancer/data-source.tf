@@ -0,0 +1,3 @@
+data "morpheus_load_balancer" "example_load_balancer" {
+  name = "F5"
+}
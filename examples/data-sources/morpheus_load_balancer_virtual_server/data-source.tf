@@ -0,0 +1,4 @@
+data "morpheus_load_balancer_virtual_server" "example_load_balancer_virtual_server" {
+  load_balancer_id = data.morpheus_load_balancer.example_load_balancer.id
+  name             = "web"
+}
@@ -0,0 +1,3 @@
+data "morpheus_security_group" "example_security_group" {
+  name = "web"
+}
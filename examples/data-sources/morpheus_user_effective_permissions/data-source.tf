@@ -0,0 +1,10 @@
+data "morpheus_user_effective_permissions" "example_user_effective_permissions" {
+  username = "tfexample"
+}
+
+output "full_access_features" {
+  value = [
+    for permission in data.morpheus_user_effective_permissions.example_user_effective_permissions.feature_permissions :
+    permission.code if permission.access == "full"
+  ]
+}
@@ -0,0 +1,9 @@
+ephemeral "morpheus_cypher_secret" "tf_example_cypher_secret" {
+  key = "dbpassword"
+}
+
+resource "morpheus_cypher_secret" "tf_example_cypher_secret_copy" {
+  key              = "dbpassword-copy"
+  value_wo         = ephemeral.morpheus_cypher_secret.tf_example_cypher_secret.value
+  value_wo_version = 1
+}
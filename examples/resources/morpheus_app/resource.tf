@@ -0,0 +1,29 @@
+resource "morpheus_app" "tf_example_app" {
+  name             = "tf_example_app"
+  description      = "Terraform app example"
+  labels           = ["demo", "terraform"]
+  blueprint_id     = morpheus_terraform_app_blueprint.tf_example_terraform_app_blueprint.id
+  group_id         = 1
+  default_cloud_id = 1
+  environment      = "dev"
+
+  tier {
+    name = "web"
+    config = jsonencode({
+      instances = [
+        {
+          instance = {
+            type = "nginx"
+          }
+          plan = {
+            id = 10
+          }
+        }
+      ]
+    })
+  }
+
+  timeouts {
+    create = "90m"
+  }
+}
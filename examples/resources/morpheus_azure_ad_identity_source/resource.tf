@@ -0,0 +1,24 @@
+data "morpheus_tenant" "demo_tenant" {
+  name = "Demo"
+}
+
+resource "morpheus_azure_ad_identity_source" "tf_example_azure_ad_identity_source" {
+  tenant_id                      = data.morpheus_tenant.demo_tenant.id
+  name                           = "azureaddemo"
+  description                    = "TF example Azure AD identity source"
+  login_redirect_url             = "https://login.microsoftonline.com/00000000-0000-0000-0000-000000000000/saml2"
+  logout_redirect_url            = "https://login.microsoftonline.com/00000000-0000-0000-0000-000000000000/saml2"
+  validate_assertion_signature   = true
+  given_name_attribute           = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname"
+  surname_attribute              = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname"
+  email_attribute                = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress"
+  role_attribute_name            = "http://schemas.microsoft.com/ws/2008/06/identity/claims/groups"
+  default_account_role_id        = 4
+  enable_role_mapping_permission = false
+
+  role_mapping {
+    role_id             = 5
+    role_name           = "tf-example-user-role"
+    assertion_attribute = "11111111-1111-1111-1111-111111111111"
+  }
+}
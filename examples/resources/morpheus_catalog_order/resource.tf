@@ -0,0 +1,12 @@
+resource "morpheus_catalog_order" "tf_example_catalog_order" {
+  catalog_item_id = morpheus_instance_catalog_item.tf_example_instance_catalog_item.id
+  config = {
+    name        = "tfexample-web-01"
+    environment = "production"
+    tags        = jsonencode(["web", "terraform"])
+  }
+
+  timeouts {
+    create = "90m"
+  }
+}
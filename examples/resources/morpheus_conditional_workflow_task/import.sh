@@ -0,0 +1 @@
+terraform import morpheus_conditional_workflow_task.tf_example_conditional_workflow_task 1
\ No newline at end of file
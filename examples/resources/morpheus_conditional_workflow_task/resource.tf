@@ -0,0 +1,13 @@
+resource "morpheus_conditional_workflow_task" "tf_example_conditional_workflow_task" {
+  name                  = "tf_example_conditional_workflow_task"
+  code                  = "tf_example_conditional_workflow_task"
+  labels                = ["demo", "terraform"]
+  condition_script      = "instance.plan.name == 'Large'"
+  success_workflow_id   = morpheus_operational_workflow.tf_example_large.id
+  success_workflow_name = morpheus_operational_workflow.tf_example_large.name
+  failure_workflow_id   = morpheus_operational_workflow.tf_example_small.id
+  failure_workflow_name = morpheus_operational_workflow.tf_example_small.name
+  visibility            = "private"
+  retryable             = false
+  allow_custom_config   = false
+}
@@ -0,0 +1,18 @@
+resource "morpheus_cypher_generated_secret" "tf_example_vm_password" {
+  mount        = "password"
+  key          = "tf-example-vm"
+  length       = 20
+  ttl          = 2592000
+  renew_before = 604800
+}
+
+resource "morpheus_cypher_generated_secret" "tf_example_aes_key" {
+  mount  = "key"
+  key    = "tf-example-app"
+  length = 256
+}
+
+output "vm_password" {
+  value     = morpheus_cypher_generated_secret.tf_example_vm_password.value
+  sensitive = true
+}
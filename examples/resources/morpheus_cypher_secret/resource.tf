@@ -2,4 +2,10 @@ resource "morpheus_cypher_secret" "tf_example_cypher_secret" {
   key   = "apipassword"
   value = "password123"
   ttl   = 86400
-}
\ No newline at end of file
+}
+
+resource "morpheus_cypher_secret" "tf_example_cypher_secret_write_only" {
+  key              = "dbpassword"
+  value_wo         = var.db_password
+  value_wo_version = 1
+}
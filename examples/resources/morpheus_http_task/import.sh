@@ -0,0 +1 @@
+terraform import morpheus_http_task.tf_example_http_task 1
\ No newline at end of file
@@ -0,0 +1,22 @@
+resource "morpheus_http_task" "tf_example_http_task" {
+  name                = "tf_example_http_task"
+  code                = "tf_example_http_task"
+  labels              = ["demo", "terraform"]
+  result_type         = "json"
+  url                 = "https://api.example.com/v1/servers"
+  method              = "POST"
+  body                = jsonencode({ name = "<%= instance.name %>" })
+  auth_username       = "admin"
+  auth_password       = "password123"
+  ignore_ssl_errors   = false
+  visibility          = "private"
+  retryable           = true
+  retry_count         = 1
+  retry_delay_seconds = 10
+  allow_custom_config = true
+
+  headers = {
+    "Content-Type" = "application/json"
+    "Accept"       = "application/json"
+  }
+}
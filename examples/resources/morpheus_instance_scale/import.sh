@@ -0,0 +1 @@
+terraform import morpheus_instance_scale.tf_example_instance_scale 1
\ No newline at end of file
@@ -0,0 +1,23 @@
+resource "morpheus_scale_threshold" "tf_example_business_hours" {
+  name           = "business hours"
+  auto_upscale   = true
+  auto_downscale = true
+  min_count      = 4
+  max_count      = 10
+}
+
+resource "morpheus_instance_scale" "tf_example_instance_scale" {
+  instance_id        = morpheus_vsphere_instance.tf_example_vsphere_instance.id
+  scale_threshold_id = morpheus_scale_threshold.tf_example_scale_threshold.id
+  min_count          = 2
+  max_count          = 6
+  load_balancer_id   = 3
+
+  schedule {
+    scale_threshold_id = morpheus_scale_threshold.tf_example_business_hours.id
+    start_day_of_week  = 2
+    start_time         = "08:00"
+    end_day_of_week    = 6
+    end_time           = "18:00"
+  }
+}
@@ -0,0 +1,30 @@
+data "morpheus_tenant" "demo_tenant" {
+  name = "Demo"
+}
+
+resource "morpheus_ldap_identity_source" "tf_example_ldap_identity_source" {
+  tenant_id                      = data.morpheus_tenant.demo_tenant.id
+  name                           = "ldapdemo"
+  description                    = "TF example LDAP identity source"
+  url                            = "ldaps://ldap.test.local:636"
+  binding_username               = "cn=admin,dc=test,dc=local"
+  binding_password               = "password"
+  required_group                 = "morpheus-users"
+  user_fqn_expression            = "uid=$username,ou=users,dc=test,dc=local"
+  username_attribute             = "uid"
+  common_name_attribute          = "cn"
+  first_name_attribute           = "givenName"
+  last_name_attribute            = "sn"
+  email_attribute                = "mail"
+  unique_member_attribute        = "uniqueMember"
+  member_of_attribute            = "memberOf"
+  default_account_role_id        = 4
+  enable_role_mapping_permission = false
+
+  role_mapping {
+    role_id         = 5
+    role_name       = "tf-example-user-role"
+    ldap_group_name = "developers"
+    ldap_group_fqn  = "cn=developers,ou=groups,dc=test,dc=local"
+  }
+}
@@ -0,0 +1,11 @@
+resource "morpheus_load_balancer" "tf_example_load_balancer" {
+  name          = "tf-example-f5"
+  description   = "F5 load balancer"
+  type_code     = "f5"
+  visibility    = "private"
+  enabled       = true
+  host          = "f5.example.local"
+  api_port      = 443
+  admin_port    = 8443
+  credential_id = 2
+}
@@ -0,0 +1,11 @@
+resource "morpheus_load_balancer_monitor" "tf_example_load_balancer_monitor" {
+  load_balancer_id = morpheus_load_balancer.tf_example_load_balancer.id
+  name             = "tf-example-http-monitor"
+  description      = "HTTP health check"
+  monitor_type     = "http"
+  interval         = 5
+  timeout          = 16
+  send_data        = "GET /health HTTP/1.1\\r\\nHost: example.local\\r\\n\\r\\n"
+  receive_code     = "200"
+  destination      = "*:8080"
+}
@@ -0,0 +1 @@
+terraform import morpheus_load_balancer_pool.tf_example_load_balancer_pool 1:2
\ No newline at end of file
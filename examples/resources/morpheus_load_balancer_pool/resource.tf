@@ -0,0 +1,10 @@
+resource "morpheus_load_balancer_pool" "tf_example_load_balancer_pool" {
+  load_balancer_id       = morpheus_load_balancer.tf_example_load_balancer.id
+  name                   = "tf-example-pool"
+  description            = "Web server pool"
+  balance_mode           = "roundrobin"
+  port                   = 8080
+  minimum_active_members = 1
+  monitor_ids            = [morpheus_load_balancer_monitor.tf_example_load_balancer_monitor.id]
+  enabled                = true
+}
@@ -0,0 +1,11 @@
+resource "morpheus_load_balancer_virtual_server" "tf_example_load_balancer_virtual_server" {
+  load_balancer_id = morpheus_load_balancer.tf_example_load_balancer.id
+  name             = "tf-example-vip"
+  description      = "Web server virtual server"
+  vip_address      = "10.0.0.100"
+  vip_port         = 443
+  vip_protocol     = "https"
+  vip_hostname     = "www.example.local"
+  pool_id          = morpheus_load_balancer_pool.tf_example_load_balancer_pool.id
+  sticky           = true
+}
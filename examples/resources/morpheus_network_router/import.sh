@@ -0,0 +1 @@
+terraform import morpheus_network_router.tf_example_network_router 1
\ No newline at end of file
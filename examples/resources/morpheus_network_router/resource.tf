@@ -0,0 +1,24 @@
+resource "morpheus_router_quota_policy" "tf_example_router_quota_policy" {
+  name        = "tf-example-router-quota"
+  description = "Limit the routers of the cloud"
+  enabled     = true
+  max_routers = 5
+  scope       = "cloud"
+  cloud_id    = 1
+}
+
+resource "morpheus_network_router" "tf_example_network_router" {
+  name              = "tf-example-tier1"
+  description       = "NSX-T tier-1 gateway"
+  type_code         = "nsx-t-tier1"
+  network_server_id = 2
+  cloud_id          = morpheus_router_quota_policy.tf_example_router_quota_policy.cloud_id
+  enabled           = true
+  enable_bgp        = false
+  visibility        = "private"
+
+  config = jsonencode({
+    tier0Gateway = "/infra/tier-0s/t0-gateway"
+    edgeCluster  = "edge-cluster-01"
+  })
+}
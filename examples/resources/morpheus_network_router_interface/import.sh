@@ -0,0 +1 @@
+terraform import morpheus_network_router_interface.tf_example_network_router_interface 1:2
\ No newline at end of file
@@ -0,0 +1,9 @@
+resource "morpheus_network_router_interface" "tf_example_network_router_interface" {
+  network_router_id = morpheus_network_router.tf_example_network_router.id
+  name              = "web-segment"
+  interface_type    = "internal"
+  network_id        = 4
+  ip_address        = "10.10.0.1"
+  cidr              = "10.10.0.0/24"
+  enabled           = true
+}
@@ -0,0 +1 @@
+terraform import morpheus_network_router_nat.tf_example_network_router_nat 1:2
\ No newline at end of file
@@ -0,0 +1,10 @@
+resource "morpheus_network_router_nat" "tf_example_network_router_nat" {
+  network_router_id  = morpheus_network_router.tf_example_network_router.id
+  name               = "web-snat"
+  description        = "Translate the web segment to the public address"
+  action             = "SNAT"
+  source_network     = "10.10.0.0/24"
+  translated_network = "203.0.113.10"
+  priority           = 100
+  enabled            = true
+}
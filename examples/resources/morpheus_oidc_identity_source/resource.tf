@@ -0,0 +1,25 @@
+data "morpheus_tenant" "demo_tenant" {
+  name = "Demo"
+}
+
+resource "morpheus_oidc_identity_source" "tf_example_oidc_identity_source" {
+  tenant_id                      = data.morpheus_tenant.demo_tenant.id
+  name                           = "oidcdemo"
+  description                    = "TF example OIDC identity source"
+  url                            = "https://keycloak.test.local:8443/realms/master"
+  client_id                      = "morpheus"
+  client_secret                  = "secret"
+  username_attribute             = "preferred_username"
+  given_name_attribute           = "given_name"
+  surname_attribute              = "family_name"
+  email_attribute                = "email"
+  role_attribute_name            = "groups"
+  default_account_role_id        = 4
+  enable_role_mapping_permission = false
+
+  role_mapping {
+    role_id     = 5
+    role_name   = "tf-example-user-role"
+    claim_value = "developers"
+  }
+}
@@ -0,0 +1 @@
+terraform import morpheus_okta_identity_source.tf_example_okta_identity_source 1
\ No newline at end of file
@@ -0,0 +1,20 @@
+data "morpheus_tenant" "demo_tenant" {
+  name = "Demo"
+}
+
+resource "morpheus_okta_identity_source" "tf_example_okta_identity_source" {
+  tenant_id                      = data.morpheus_tenant.demo_tenant.id
+  name                           = "oktademo"
+  description                    = "TF example Okta identity source"
+  url                            = "https://example.okta.com"
+  administrator_api_token        = "00aBcDeFgHiJkLmNoPqRsTuVwXyZ"
+  required_group                 = "morpheus-users"
+  default_account_role_id        = 4
+  enable_role_mapping_permission = false
+
+  role_mapping {
+    role_id         = 5
+    role_name       = "tf-example-user-role"
+    okta_group_name = "developers"
+  }
+}
@@ -8,5 +8,14 @@ resource "morpheus_operational_workflow" "tf_example_operational_workflow" {
   option_types = [
     1730
   ]
-  task_ids = [18]
-}
\ No newline at end of file
+  task {
+    task_id = 18
+    inputs = {
+      environment = "production"
+    }
+  }
+  # Nested workflows are run through a morpheus_nested_workflow_task
+  task {
+    task_id = 20
+  }
+}
@@ -8,4 +8,16 @@ resource "morpheus_provisioning_workflow" "tf_example_provisioning_workflow" {
     task_id    = 18
     task_phase = "configure"
   }
-}
\ No newline at end of file
+  task {
+    task_id    = 19
+    task_phase = "postProvision"
+    inputs = {
+      environment = "production"
+    }
+  }
+  # Nested workflows are run through a morpheus_nested_workflow_task
+  task {
+    task_id    = 20
+    task_phase = "postProvision"
+  }
+}
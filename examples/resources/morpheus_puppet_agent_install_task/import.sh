@@ -0,0 +1 @@
+terraform import morpheus_puppet_agent_install_task.tf_example_puppet_agent_install_task 1
\ No newline at end of file
@@ -0,0 +1,14 @@
+resource "morpheus_puppet_agent_install_task" "tf_example_puppet_agent_install_task" {
+  name                = "tf_example_puppet_agent_install_task"
+  code                = "tf_example_puppet_agent_install_task"
+  labels              = ["demo", "terraform"]
+  puppet_master_id    = 2
+  puppet_environment  = "production"
+  puppet_node_name    = "<%= instance.hostname %>"
+  execute_target      = "resource"
+  visibility          = "private"
+  retryable           = true
+  retry_count         = 1
+  retry_delay_seconds = 10
+  allow_custom_config = false
+}
@@ -0,0 +1 @@
+terraform import morpheus_role_catalog_item_type_permission.tf_example_role_catalog_item_type_permission 1:2
\ No newline at end of file
@@ -0,0 +1,14 @@
+data "morpheus_catalog_item_type" "demo" {
+  name = "Demo"
+}
+
+resource "morpheus_user_role" "tf_example_user_role" {
+  name        = "tf-example-user-role"
+  description = "Terraform provider example user role"
+}
+
+resource "morpheus_role_catalog_item_type_permission" "tf_example_role_catalog_item_type_permission" {
+  role_id              = morpheus_user_role.tf_example_user_role.id
+  catalog_item_type_id = data.morpheus_catalog_item_type.demo.id
+  access               = "full"
+}
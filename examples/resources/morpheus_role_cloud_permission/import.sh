@@ -0,0 +1 @@
+terraform import morpheus_role_cloud_permission.tf_example_role_cloud_permission 1:2
\ No newline at end of file
@@ -0,0 +1,14 @@
+data "morpheus_cloud" "demo" {
+  name = "Demo"
+}
+
+resource "morpheus_user_role" "tf_example_user_role" {
+  name        = "tf-example-user-role"
+  description = "Terraform provider example user role"
+}
+
+resource "morpheus_role_cloud_permission" "tf_example_role_cloud_permission" {
+  role_id  = morpheus_user_role.tf_example_user_role.id
+  cloud_id = data.morpheus_cloud.demo.id
+  access   = "read"
+}
@@ -0,0 +1 @@
+terraform import morpheus_role_feature_permission.tf_example_role_feature_permission 1:admin-appliance
\ No newline at end of file
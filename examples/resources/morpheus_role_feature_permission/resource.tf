@@ -0,0 +1,10 @@
+resource "morpheus_user_role" "tf_example_user_role" {
+  name        = "tf-example-user-role"
+  description = "Terraform provider example user role"
+}
+
+resource "morpheus_role_feature_permission" "tf_example_role_feature_permission" {
+  role_id = morpheus_user_role.tf_example_user_role.id
+  code    = "provisioning-library"
+  access  = "full"
+}
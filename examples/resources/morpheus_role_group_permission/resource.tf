@@ -0,0 +1,14 @@
+data "morpheus_group" "demo" {
+  name = "Demo"
+}
+
+resource "morpheus_user_role" "tf_example_user_role" {
+  name        = "tf-example-user-role"
+  description = "Terraform provider example user role"
+}
+
+resource "morpheus_role_group_permission" "tf_example_role_group_permission" {
+  role_id  = morpheus_user_role.tf_example_user_role.id
+  group_id = data.morpheus_group.demo.id
+  access   = "full"
+}
@@ -0,0 +1,11 @@
+resource "morpheus_security_group" "tf_example_security_group" {
+  name        = "tf-example-web"
+  description = "Web servers"
+  visibility  = "private"
+  active      = true
+
+  location {
+    cloud_id         = 1
+    resource_pool_id = 3
+  }
+}
@@ -0,0 +1 @@
+terraform import morpheus_security_group_rule.tf_example_security_group_rule_https 1:2
\ No newline at end of file
@@ -0,0 +1,22 @@
+resource "morpheus_security_group_rule" "tf_example_security_group_rule_https" {
+  security_group_id = morpheus_security_group.tf_example_security_group.id
+  name              = "https"
+  direction         = "ingress"
+  policy            = "accept"
+  protocol          = "tcp"
+  port_range        = "443"
+  source_type       = "cidr"
+  source            = "10.0.0.0/16"
+  destination_type  = "instance"
+}
+
+resource "morpheus_security_group_rule" "tf_example_security_group_rule_app" {
+  security_group_id = morpheus_security_group.tf_example_security_group.id
+  name              = "app"
+  direction         = "ingress"
+  protocol          = "tcp"
+  port_range        = "8000-8100"
+  source_type       = "group"
+  source_group_id   = 4
+  destination_type  = "instance"
+}
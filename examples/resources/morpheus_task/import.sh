@@ -0,0 +1 @@
+terraform import morpheus_task.tf_example_task 1
\ No newline at end of file
@@ -0,0 +1,18 @@
+resource "morpheus_task" "tf_example_task" {
+  name                = "tf_example_plugin_task"
+  code                = "tf_example_plugin_task"
+  task_type_code      = "servicenowIncidentTask"
+  labels              = ["demo", "terraform"]
+  result_type         = "json"
+  execute_target      = "local"
+  visibility          = "private"
+  retryable           = true
+  retry_count         = 1
+  retry_delay_seconds = 10
+  allow_custom_config = false
+
+  task_options = {
+    incidentPriority = "3"
+    incidentGroup    = "Service Desk"
+  }
+}
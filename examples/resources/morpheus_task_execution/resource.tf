@@ -0,0 +1,17 @@
+resource "morpheus_task_execution" "tf_example_task_execution" {
+  task_id      = morpheus_shell_script_task.tf_example_shell_script_task.id
+  target_type  = "instance"
+  instance_ids = [morpheus_vsphere_instance.tf_example_vsphere_instance.id]
+
+  custom_options = {
+    environment = "production"
+  }
+
+  triggers = {
+    script = morpheus_shell_script_task.tf_example_shell_script_task.script_content
+  }
+}
+
+output "task_output" {
+  value = morpheus_task_execution.tf_example_task_execution.output
+}
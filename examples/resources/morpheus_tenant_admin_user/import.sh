@@ -0,0 +1 @@
+terraform import morpheus_tenant_admin_user.tf_example_tenant_admin_user 1:2
\ No newline at end of file
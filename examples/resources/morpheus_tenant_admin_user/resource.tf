@@ -0,0 +1,21 @@
+resource "morpheus_tenant" "tf_example_tenant" {
+  name         = "tftenant"
+  description  = "Terraform example tenant"
+  enabled      = true
+  subdomain    = "tfexample"
+  base_role_id = 2
+}
+
+resource "morpheus_tenant_admin_user" "tf_example_tenant_admin_user" {
+  tenant_id  = morpheus_tenant.tf_example_tenant.id
+  username   = "tenantadmin"
+  first_name = "Tenant"
+  last_name  = "Admin"
+  email      = "tenantadmin@test.local"
+  password   = var.tenant_admin_password
+  role_ids   = [4]
+}
+
+output "tenant_admin_login" {
+  value = morpheus_tenant_admin_user.tf_example_tenant_admin_user.login_username
+}
@@ -0,0 +1,16 @@
+resource "morpheus_tenant_resource_assignment" "tf_example_tenant_resource_assignment" {
+  tenant_id   = morpheus_tenant.tf_example_tenant.id
+  group_id    = 5
+  cloud_ids   = [1, 2]
+  network_ids = [10, 11]
+
+  datastore {
+    cloud_id     = 1
+    datastore_id = 20
+  }
+
+  resource_pool {
+    cloud_id         = 1
+    resource_pool_id = 30
+  }
+}
@@ -0,0 +1,16 @@
+resource "morpheus_user" "tf_example_ci_user" {
+  username   = "ci-service"
+  first_name = "CI"
+  last_name  = "Service"
+  email      = "ci-service@test.local"
+  password   = var.ci_service_password
+  role_ids   = [19]
+}
+
+resource "morpheus_user_api_token" "tf_example_user_api_token" {
+  username          = morpheus_user.tf_example_ci_user.username
+  password_wo       = var.ci_service_password
+  client_id         = "morph-api"
+  scope             = "write"
+  renew_before_days = 30
+}
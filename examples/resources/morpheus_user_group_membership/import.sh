@@ -0,0 +1 @@
+terraform import morpheus_user_group_membership.tf_example_user_group_membership 1:2
\ No newline at end of file
@@ -0,0 +1,12 @@
+resource "morpheus_user_group" "tf_example_user_group" {
+  name              = "tftest"
+  description       = "terraform"
+  sudo_access       = true
+  server_group      = "test"
+  ignore_membership = true
+}
+
+resource "morpheus_user_group_membership" "tf_example_user_group_membership" {
+  user_group_id = morpheus_user_group.tf_example_user_group.id
+  user_id       = 19
+}
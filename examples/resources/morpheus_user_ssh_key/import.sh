@@ -0,0 +1 @@
+terraform import morpheus_user_ssh_key.tf_example_user_ssh_key 1:2
\ No newline at end of file
@@ -0,0 +1,15 @@
+resource "morpheus_user" "tf_example_ci_user" {
+  username       = "ci-service"
+  first_name     = "CI"
+  last_name      = "Service"
+  email          = "ci-service@test.local"
+  password       = var.ci_service_password
+  role_ids       = [19]
+  linux_username = "ci"
+}
+
+resource "morpheus_user_ssh_key" "tf_example_user_ssh_key" {
+  user_id    = morpheus_user.tf_example_ci_user.id
+  name       = "ci-service"
+  public_key = file("~/.ssh/ci_service.pub")
+}
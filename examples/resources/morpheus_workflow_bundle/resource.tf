@@ -0,0 +1,39 @@
+resource "morpheus_workflow_bundle" "tf_example_workflow_bundle" {
+  bundle = file("${path.module}/workflows/tf_example_workflow.json")
+}
+
+resource "morpheus_workflow_bundle" "tf_example_workflow_bundle_inline" {
+  bundle = jsonencode({
+    optionTypes = [
+      {
+        name       = "Environment"
+        code       = "tf_example_environment"
+        fieldName  = "environment"
+        fieldLabel = "Environment"
+        type       = "text"
+      }
+    ]
+    tasks = [
+      {
+        name     = "tf_example_shell_task"
+        code     = "tf_example_shell_task"
+        taskType = { code = "script" }
+        taskOptions = {
+          shell = "echo <%= customOptions.environment %>"
+        }
+      }
+    ]
+    workflow = {
+      name        = "tf_example_workflow_bundle"
+      type        = "operation"
+      optionTypes = [{ code = "tf_example_environment" }]
+      taskSetTasks = [
+        {
+          taskPhase = "operation"
+          taskOrder = 0
+          task      = { code = "tf_example_shell_task" }
+        }
+      ]
+    }
+  })
+}
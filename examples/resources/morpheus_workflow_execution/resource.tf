@@ -0,0 +1,12 @@
+resource "morpheus_workflow_execution" "tf_example_workflow_execution" {
+  workflow_id = morpheus_operational_workflow.tf_example_operational_workflow.id
+  target_type = "appliance"
+
+  custom_options = {
+    ticketNumber = "CHG0001234"
+  }
+
+  triggers = {
+    release = "1.2.0"
+  }
+}
@@ -5,15 +5,31 @@
 package main
 
 import (
+	"context"
+	"log"
+
 	"github.com/gomorpheus/terraform-provider-morpheus/morpheus"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 )
 
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: func() *schema.Provider {
-			return morpheus.Provider()
-		},
-	})
+	ctx := context.Background()
+
+	// The ephemeral resources are served by the plugin framework next to the SDK provider
+	providers := []func() tfprotov5.ProviderServer{
+		morpheus.Provider().GRPCProvider,
+		providerserver.NewProtocol5(morpheus.FrameworkProvider()),
+	}
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = tf5server.Serve("registry.terraform.io/gomorpheus/morpheus", muxServer.ProviderServer)
+	if err != nil {
+		log.Fatal(err)
+	}
 }
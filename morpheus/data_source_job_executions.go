@@ -0,0 +1,226 @@
+package morpheus
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// jobExecutionsPageSize is the number of job executions fetched per request
+const jobExecutionsPageSize = 250
+
+func dataSourceMorpheusJobExecutions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus job executions data source.",
+		ReadContext: dataSourceMorpheusJobExecutionsRead,
+		Schema: map[string]*schema.Schema{
+			"job_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the job to list the executions of",
+				Required:    true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Description:  "Only return the executions with the status (complete, failed, error, running, queued, cancelled, expired)",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"complete", "failed", "error", "running", "queued", "cancelled", "expired"}, false),
+			},
+			"started_after": {
+				Type:         schema.TypeString,
+				Description:  "Only return the executions started at or after the RFC3339 timestamp, i.e. 2024-01-01T00:00:00Z",
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"started_before": {
+				Type:         schema.TypeString,
+				Description:  "Only return the executions started before the RFC3339 timestamp, i.e. 2024-01-02T00:00:00Z",
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of executions to return, the most recent executions are returned first",
+				Optional:     true,
+				Default:      25,
+				ValidateFunc: validation.IntBetween(1, 250),
+			},
+			"executions": {
+				Type:        schema.TypeList,
+				Description: "The executions of the job",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the job execution",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the job execution",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "The status of the job execution",
+							Computed:    true,
+						},
+						"status_message": {
+							Type:        schema.TypeString,
+							Description: "The status message of the job execution",
+							Computed:    true,
+						},
+						"start_date": {
+							Type:        schema.TypeString,
+							Description: "The date the job execution started",
+							Computed:    true,
+						},
+						"end_date": {
+							Type:        schema.TypeString,
+							Description: "The date the job execution finished",
+							Computed:    true,
+						},
+						"duration": {
+							Type:        schema.TypeInt,
+							Description: "The duration of the job execution in milliseconds",
+							Computed:    true,
+						},
+						"output": {
+							Type:        schema.TypeString,
+							Description: "The process output of the job execution",
+							Computed:    true,
+						},
+						"error": {
+							Type:        schema.TypeString,
+							Description: "The process error of the job execution",
+							Computed:    true,
+						},
+						"result": {
+							Type:        schema.TypeString,
+							Description: "The result data of the job execution",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusJobExecutionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	jobId := d.Get("job_id").(int)
+	status := d.Get("status").(string)
+	maxResults := d.Get("max_results").(int)
+
+	var startedAfter, startedBefore time.Time
+	if value := d.Get("started_after").(string); value != "" {
+		startedAfter, _ = time.Parse(time.RFC3339, value)
+	}
+	if value := d.Get("started_before").(string); value != "" {
+		startedBefore, _ = time.Parse(time.RFC3339, value)
+	}
+
+	// The status and time window are filtered here so page through the executions, most recent first,
+	// until enough executions matched or the history is exhausted
+	executions := make([]map[string]interface{}, 0)
+	for offset := 0; len(executions) < maxResults; offset += jobExecutionsPageSize {
+		resp, err := client.ListJobExecutions(&morpheus.Request{
+			QueryParams: map[string]string{
+				"jobId":     strconv.Itoa(jobId),
+				"max":       strconv.Itoa(jobExecutionsPageSize),
+				"offset":    strconv.Itoa(offset),
+				"sort":      "id",
+				"direction": "desc",
+			},
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("API 404: %s - %v", resp, err)
+				return nil
+			} else {
+				log.Printf("API FAILURE: %s - %v", resp, err)
+				return diag.FromErr(err)
+			}
+		}
+		log.Printf("API RESPONSE: %s", resp)
+
+		// store resource data
+		result := resp.Result.(*morpheus.ListJobExecutionsResult)
+		if result.JobExecutions == nil {
+			break
+		}
+		for _, jobExecution := range *result.JobExecutions {
+			if len(executions) >= maxResults {
+				break
+			}
+			if jobExecution.Job.ID != 0 && jobExecution.Job.ID != int64(jobId) {
+				continue
+			}
+			if status != "" && jobExecution.Status != status {
+				continue
+			}
+			if !startedAfter.IsZero() || !startedBefore.IsZero() {
+				startDate, err := time.Parse(time.RFC3339, jobExecution.StartDate)
+				if err != nil {
+					continue
+				}
+				if !startedAfter.IsZero() && startDate.Before(startedAfter) {
+					continue
+				}
+				if !startedBefore.IsZero() && !startDate.Before(startedBefore) {
+					continue
+				}
+			}
+
+			output := jobExecution.Process.Output
+			errorOutput := jobExecution.Process.Error
+			if output == "" && errorOutput == "" {
+				// Workflow executions report the output on the event of each task
+				var outputs, errors []string
+				for _, event := range jobExecution.Process.Events {
+					if event.Output != "" {
+						outputs = append(outputs, event.Output)
+					}
+					if event.Error != "" {
+						errors = append(errors, event.Error)
+					}
+				}
+				output = strings.Join(outputs, "\n")
+				errorOutput = strings.Join(errors, "\n")
+			}
+
+			executions = append(executions, map[string]interface{}{
+				"id":             jobExecution.ID,
+				"name":           jobExecution.Name,
+				"status":         jobExecution.Status,
+				"status_message": jobExecution.StatusMessage,
+				"start_date":     jobExecution.StartDate,
+				"end_date":       jobExecution.EndDate,
+				"duration":       jobExecution.Duration,
+				"output":         output,
+				"error":          errorOutput,
+				"result":         jobExecution.ResultData,
+			})
+		}
+		if len(*result.JobExecutions) < jobExecutionsPageSize {
+			break
+		}
+	}
+
+	d.SetId(strconv.Itoa(jobId))
+	d.Set("executions", executions)
+	return diags
+}
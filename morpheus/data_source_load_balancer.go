@@ -0,0 +1,110 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMorpheusLoadBalancer() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus load balancer data source.",
+		ReadContext: dataSourceMorpheusLoadBalancerRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the load balancer",
+				Optional:      true,
+				ConflictsWith: []string{"name"},
+				Computed:      true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Description:   "The name of the Morpheus load balancer.",
+				Optional:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the load balancer",
+				Computed:    true,
+			},
+			"type_code": {
+				Type:        schema.TypeString,
+				Description: "The code of the load balancer type",
+				Computed:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the cloud the load balancer is associated with",
+				Computed:    true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "The hostname or ip address of the load balancer management api",
+				Computed:    true,
+			},
+			"visibility": {
+				Type:        schema.TypeString,
+				Description: "The visibility of the load balancer",
+				Computed:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the load balancer is enabled",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+	id := d.Get("id").(int)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == 0 && name != "" {
+		resp, err = client.FindLoadBalancerByName(name)
+	} else if id != 0 {
+		resp, err = client.GetLoadBalancer(int64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Load balancer cannot be read without name or id")
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %v", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetLoadBalancerResult)
+	loadBalancer := result.LoadBalancer
+	if loadBalancer != nil {
+		d.SetId(int64ToString(loadBalancer.ID))
+		d.Set("name", loadBalancer.Name)
+		d.Set("description", loadBalancer.Description)
+		d.Set("type_code", loadBalancer.Type.Code)
+		d.Set("cloud_id", loadBalancer.Cloud.ID)
+		d.Set("host", loadBalancer.Host)
+		d.Set("visibility", loadBalancer.Visibility)
+		d.Set("enabled", loadBalancer.Enabled)
+	} else {
+		return diag.Errorf("Load balancer not found in response data.") // should not happen
+	}
+	return diags
+}
@@ -0,0 +1,113 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMorpheusLoadBalancerMonitor() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus load balancer monitor data source.",
+		ReadContext: dataSourceMorpheusLoadBalancerMonitorRead,
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the load balancer to search for the monitor.",
+				Required:    true,
+			},
+			"id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the load balancer monitor",
+				Optional:      true,
+				ConflictsWith: []string{"name"},
+				Computed:      true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Description:   "The name of the Morpheus load balancer monitor.",
+				Optional:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the load balancer monitor",
+				Computed:    true,
+			},
+			"monitor_type": {
+				Type:        schema.TypeString,
+				Description: "The type of health check performed by the monitor",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the load balancer monitor",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusLoadBalancerMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+	id := d.Get("id").(int)
+	loadBalancerId := int64(d.Get("load_balancer_id").(int))
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == 0 && name != "" {
+		resp, err = client.ListLoadBalancerMonitors(loadBalancerId, &morpheus.Request{
+			QueryParams: map[string]string{
+				"name": name,
+			},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		result := resp.Result.(*morpheus.ListLoadBalancerMonitorsResult)
+		count := len(*result.LoadBalancerMonitors)
+		if count != 1 {
+			return diag.Errorf("found %d load balancer monitors for %v", count, name)
+		}
+		resp, err = client.GetLoadBalancerMonitor(loadBalancerId, (*result.LoadBalancerMonitors)[0].ID, &morpheus.Request{})
+	} else if id != 0 {
+		resp, err = client.GetLoadBalancerMonitor(loadBalancerId, int64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Load balancer monitor cannot be read without name or id")
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %v", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetLoadBalancerMonitorResult)
+	monitor := result.LoadBalancerMonitor
+	if monitor != nil {
+		d.SetId(int64ToString(monitor.ID))
+		d.Set("name", monitor.Name)
+		d.Set("description", monitor.Description)
+		d.Set("monitor_type", monitor.MonitorType)
+		d.Set("status", monitor.Status)
+	} else {
+		return diag.Errorf("Load balancer monitor not found in response data.") // should not happen
+	}
+	return diags
+}
@@ -0,0 +1,119 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMorpheusLoadBalancerPool() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus load balancer pool data source.",
+		ReadContext: dataSourceMorpheusLoadBalancerPoolRead,
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the load balancer to search for the pool.",
+				Required:    true,
+			},
+			"id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the load balancer pool",
+				Optional:      true,
+				ConflictsWith: []string{"name"},
+				Computed:      true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Description:   "The name of the Morpheus load balancer pool.",
+				Optional:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the load balancer pool",
+				Computed:    true,
+			},
+			"balance_mode": {
+				Type:        schema.TypeString,
+				Description: "The algorithm used to distribute traffic across the pool members",
+				Computed:    true,
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Description: "The port the pool members receive traffic on",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the load balancer pool",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusLoadBalancerPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+	id := d.Get("id").(int)
+	loadBalancerId := int64(d.Get("load_balancer_id").(int))
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == 0 && name != "" {
+		resp, err = client.ListLoadBalancerPools(loadBalancerId, &morpheus.Request{
+			QueryParams: map[string]string{
+				"name": name,
+			},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		result := resp.Result.(*morpheus.ListLoadBalancerPoolsResult)
+		count := len(*result.LoadBalancerPools)
+		if count != 1 {
+			return diag.Errorf("found %d load balancer pools for %v", count, name)
+		}
+		resp, err = client.GetLoadBalancerPool(loadBalancerId, (*result.LoadBalancerPools)[0].ID, &morpheus.Request{})
+	} else if id != 0 {
+		resp, err = client.GetLoadBalancerPool(loadBalancerId, int64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Load balancer pool cannot be read without name or id")
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %v", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetLoadBalancerPoolResult)
+	pool := result.LoadBalancerPool
+	if pool != nil {
+		d.SetId(int64ToString(pool.ID))
+		d.Set("name", pool.Name)
+		d.Set("description", pool.Description)
+		d.Set("balance_mode", pool.VipBalance)
+		d.Set("port", pool.Port)
+		d.Set("status", pool.Status)
+	} else {
+		return diag.Errorf("Load balancer pool not found in response data.") // should not happen
+	}
+	return diags
+}
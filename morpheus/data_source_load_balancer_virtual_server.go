@@ -0,0 +1,130 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMorpheusLoadBalancerVirtualServer() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus load balancer virtual server data source.",
+		ReadContext: dataSourceMorpheusLoadBalancerVirtualServerRead,
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the load balancer to search for the virtual server.",
+				Required:    true,
+			},
+			"id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the load balancer virtual server",
+				Optional:      true,
+				ConflictsWith: []string{"name"},
+				Computed:      true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Description:   "The name of the Morpheus load balancer virtual server.",
+				Optional:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the load balancer virtual server",
+				Computed:    true,
+			},
+			"vip_address": {
+				Type:        schema.TypeString,
+				Description: "The virtual ip address the virtual server listens on",
+				Computed:    true,
+			},
+			"vip_port": {
+				Type:        schema.TypeInt,
+				Description: "The port the virtual server listens on",
+				Computed:    true,
+			},
+			"vip_protocol": {
+				Type:        schema.TypeString,
+				Description: "The protocol of the virtual server",
+				Computed:    true,
+			},
+			"pool_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the load balancer pool that receives the traffic of the virtual server",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the load balancer virtual server",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusLoadBalancerVirtualServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+	id := d.Get("id").(int)
+	loadBalancerId := int64(d.Get("load_balancer_id").(int))
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == 0 && name != "" {
+		resp, err = client.ListLoadBalancerVirtualServers(loadBalancerId, &morpheus.Request{
+			QueryParams: map[string]string{
+				"name": name,
+			},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		result := resp.Result.(*morpheus.ListLoadBalancerVirtualServersResult)
+		count := len(*result.LoadBalancerVirtualServers)
+		if count != 1 {
+			return diag.Errorf("found %d load balancer virtual servers for %v", count, name)
+		}
+		resp, err = client.GetLoadBalancerVirtualServer(loadBalancerId, (*result.LoadBalancerVirtualServers)[0].ID, &morpheus.Request{})
+	} else if id != 0 {
+		resp, err = client.GetLoadBalancerVirtualServer(loadBalancerId, int64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Load balancer virtual server cannot be read without name or id")
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %v", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// The sdk parses the virtual server into the wrong type so parse the body directly
+	var virtualServer LoadBalancerVirtualServerPayload
+	if err := json.Unmarshal(resp.Body, &virtualServer); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(int64ToString(virtualServer.LoadBalancerInstance.ID))
+	d.Set("name", virtualServer.LoadBalancerInstance.VipName)
+	d.Set("description", virtualServer.LoadBalancerInstance.Description)
+	d.Set("vip_address", virtualServer.LoadBalancerInstance.VipAddress)
+	d.Set("vip_port", virtualServer.LoadBalancerInstance.VipPort)
+	d.Set("vip_protocol", virtualServer.LoadBalancerInstance.VipProtocol)
+	d.Set("pool_id", virtualServer.LoadBalancerInstance.Pool.ID)
+	d.Set("status", virtualServer.LoadBalancerInstance.VipStatus)
+	return diags
+}
@@ -3,10 +3,14 @@ package morpheus
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"sort"
 	"strconv"
+	"strings"
+	"sync"
 
+	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -119,7 +123,7 @@ func dataSourceMorpheusPermissionSet() *schema.Resource {
 							Type:         schema.TypeString,
 							Description:  "The level of access granted to the feature permission (full, full_decrypted, group, listfiles, managerules, no, none, provision, read, rolemappings, user, view, yes)",
 							Optional:     true,
-							ValidateFunc: validation.StringInSlice([]string{"full", "full_decrypted", "group", "listfiles", "managerules", "no", "none", "provision", "read", "rolemappings", "user", "view", "yes"}, true),
+							ValidateFunc: validation.StringInSlice(featurePermissionAccessLevels, true),
 						},
 					},
 				},
@@ -328,6 +332,7 @@ func dataSourceMorpheusPermissionSet() *schema.Resource {
 }
 
 func dataSourceMorpheusPermissionSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -741,6 +746,19 @@ func dataSourceMorpheusPermissionSetRead(ctx context.Context, d *schema.Resource
 		}
 	}
 
+	// Report the feature codes and object ids the appliance does not know about
+	definitions, err := permissionDefinitions(client, 0, "")
+	if err != nil {
+		log.Printf("unable to read the feature permission catalog: %s", err)
+	}
+	problems, err := validatePermissionSet(client, permissionData, definitions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(problems) > 0 {
+		return diag.Errorf("invalid permission set:\n  %s", strings.Join(problems, "\n  "))
+	}
+
 	jsonDoc, err := json.MarshalIndent(permissionData, "", "  ")
 	log.Printf("API RESPONSE: %s", jsonDoc)
 
@@ -844,3 +862,208 @@ func removeDuplicate[T comparable](sliceList []T) []T {
 	}
 	return list
 }
+
+// featurePermissionAccessLevels are the access levels a feature permission can be granted,
+// the features of the appliance each accept a subset of them
+var featurePermissionAccessLevels = []string{"full", "full_decrypted", "group", "listfiles", "managerules", "no", "none", "provision", "read", "rolemappings", "user", "view", "yes"}
+
+// PermissionDefinitionsPayload is the feature permission catalog the appliance
+// reports with the details of a role
+type PermissionDefinitionsPayload struct {
+	FeaturePermissions []struct {
+		Code        string   `json:"code"`
+		Name        string   `json:"name"`
+		AccessTypes []string `json:"accessTypes"`
+	} `json:"featurePermissions"`
+}
+
+// permissionSetLookups caches the feature permission catalogs and the objects looked up while
+// validating permission sets, so every permission set of a plan does not read them again
+var permissionSetLookups = struct {
+	sync.Mutex
+	definitions map[*morpheus.Client]map[string]map[string][]string
+	objects     map[*morpheus.Client]map[string]bool
+}{
+	definitions: make(map[*morpheus.Client]map[string]map[string][]string),
+	objects:     make(map[*morpheus.Client]map[string]bool),
+}
+
+// permissionDefinitions returns the access levels accepted by each feature permission of the
+// appliance keyed by feature code. The catalog is read from the role with the given id or, when
+// it is 0, from the first role of each role type matching roleType ("" matches any type).
+func permissionDefinitions(client *morpheus.Client, roleId int64, roleType string) (map[string][]string, error) {
+	key := fmt.Sprintf("%d/%s", roleId, roleType)
+	permissionSetLookups.Lock()
+	definitions, ok := permissionSetLookups.definitions[client][key]
+	permissionSetLookups.Unlock()
+	if ok {
+		return definitions, nil
+	}
+
+	definitions, err := readPermissionDefinitions(client, roleId, roleType)
+	if err != nil {
+		return nil, err
+	}
+	permissionSetLookups.Lock()
+	if permissionSetLookups.definitions[client] == nil {
+		permissionSetLookups.definitions[client] = make(map[string]map[string][]string)
+	}
+	permissionSetLookups.definitions[client][key] = definitions
+	permissionSetLookups.Unlock()
+	return definitions, nil
+}
+
+func readPermissionDefinitions(client *morpheus.Client, roleId int64, roleType string) (map[string][]string, error) {
+	var roleIds []int64
+	if roleId != 0 {
+		roleIds = append(roleIds, roleId)
+	} else {
+		resp, err := client.ListRoles(&morpheus.Request{
+			QueryParams: map[string]string{
+				"max": "250",
+			},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return nil, err
+		}
+		seen := make(map[string]bool)
+		for _, role := range *resp.Result.(*morpheus.ListRolesResult).Roles {
+			if seen[role.RoleType] || (roleType != "" && role.RoleType != roleType) {
+				continue
+			}
+			seen[role.RoleType] = true
+			roleIds = append(roleIds, role.ID)
+		}
+	}
+
+	definitions := make(map[string][]string)
+	for _, id := range roleIds {
+		resp, err := client.GetRole(id, &morpheus.Request{})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return nil, err
+		}
+		var payload PermissionDefinitionsPayload
+		if err := json.Unmarshal(resp.Body, &payload); err != nil {
+			return nil, err
+		}
+		for _, feature := range payload.FeaturePermissions {
+			definitions[feature.Code] = feature.AccessTypes
+		}
+	}
+	return definitions, nil
+}
+
+// validatePermissionSet checks the feature codes and access levels of the permission set
+// against the feature permission catalog of the appliance and the referenced clouds, groups,
+// instance types and blueprints against existing objects, it returns the bad entries
+func validatePermissionSet(client *morpheus.Client, permissionSet PermissionSet, definitions map[string][]string) ([]string, error) {
+	var problems []string
+
+	if len(definitions) > 0 {
+		for _, feature := range permissionSet.FeaturePermissions {
+			accessTypes, ok := definitions[feature.Code]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("feature_permissions: unknown feature code %q", feature.Code))
+				continue
+			}
+			// older appliances do not report the access levels of a feature
+			if len(accessTypes) == 0 {
+				accessTypes = featurePermissionAccessLevels
+			}
+			if !containsString(accessTypes, feature.Access) {
+				problems = append(problems, fmt.Sprintf("feature_permissions: access %q is not valid for feature %q (%s)", feature.Access, feature.Code, strings.Join(accessTypes, ", ")))
+			}
+		}
+	}
+
+	type objectLookup struct {
+		attribute string
+		ids       []int
+		get       func(int64, *morpheus.Request) (*morpheus.Response, error)
+	}
+	cloudLookup := objectLookup{attribute: "cloud_permissions", get: client.GetCloud}
+	for _, permission := range permissionSet.CloudPermissions {
+		cloudLookup.ids = append(cloudLookup.ids, permission.Id)
+	}
+	groupLookup := objectLookup{attribute: "group_permissions", get: client.GetGroup}
+	for _, permission := range permissionSet.GroupPermissions {
+		groupLookup.ids = append(groupLookup.ids, permission.Id)
+	}
+	instanceTypeLookup := objectLookup{attribute: "instance_type_permissions", get: client.GetInstanceType}
+	for _, permission := range permissionSet.InstanceTypePermissions {
+		instanceTypeLookup.ids = append(instanceTypeLookup.ids, permission.Id)
+	}
+	blueprintLookup := objectLookup{attribute: "blueprint_permissions", get: client.GetBlueprint}
+	for _, permission := range permissionSet.BlueprintPermissions {
+		blueprintLookup.ids = append(blueprintLookup.ids, permission.Id)
+	}
+	for _, lookup := range []objectLookup{cloudLookup, groupLookup, instanceTypeLookup, blueprintLookup} {
+		for _, id := range lookup.ids {
+			found, err := permissionSetObjectExists(client, lookup.attribute, id, lookup.get)
+			if err != nil {
+				return nil, err
+			}
+			if !found {
+				problems = append(problems, fmt.Sprintf("%s: id %d not found", lookup.attribute, id))
+			}
+		}
+	}
+	return problems, nil
+}
+
+// permissionSetObjectExists reports whether the object referenced by the permission set exists
+func permissionSetObjectExists(client *morpheus.Client, attribute string, id int, get func(int64, *morpheus.Request) (*morpheus.Response, error)) (bool, error) {
+	key := fmt.Sprintf("%s/%d", attribute, id)
+	permissionSetLookups.Lock()
+	found, ok := permissionSetLookups.objects[client][key]
+	permissionSetLookups.Unlock()
+	if ok {
+		return found, nil
+	}
+
+	resp, err := get(int64(id), &morpheus.Request{})
+	if err != nil && (resp == nil || resp.StatusCode != 404) {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return false, fmt.Errorf("%s: unable to look up id %d: %s", attribute, id, err)
+	}
+	found = err == nil
+	permissionSetLookups.Lock()
+	if permissionSetLookups.objects[client] == nil {
+		permissionSetLookups.objects[client] = make(map[string]bool)
+	}
+	permissionSetLookups.objects[client][key] = found
+	permissionSetLookups.Unlock()
+	return found, nil
+}
+
+// permissionSetCustomizeDiff validates the permission_set JSON of a role at plan time, roleType
+// is the type of the roles the permission set is applied to (user, account)
+func permissionSetCustomizeDiff(roleType string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown("permission_set") || !d.HasChange("permission_set") || d.Get("permission_set").(string) == "" {
+			return nil
+		}
+		client := meta.(*morpheus.Client)
+
+		var permissionSet PermissionSet
+		if err := json.Unmarshal([]byte(d.Get("permission_set").(string)), &permissionSet); err != nil {
+			return fmt.Errorf("invalid permission_set: %s", err)
+		}
+
+		definitions, err := permissionDefinitions(client, toInt64(d.Id()), roleType)
+		if err != nil {
+			// the feature codes are not validated if the catalog cannot be read, i.e. insufficient permissions
+			log.Printf("unable to read the feature permission catalog: %s", err)
+		}
+		problems, err := validatePermissionSet(client, permissionSet, definitions)
+		if err != nil {
+			return err
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("invalid permission_set:\n  %s", strings.Join(problems, "\n  "))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,112 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMorpheusSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus security group data source.",
+		ReadContext: dataSourceMorpheusSecurityGroupRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the security group",
+				Optional:      true,
+				ConflictsWith: []string{"name"},
+				Computed:      true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Description:   "The name of the Morpheus security group.",
+				Optional:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the security group",
+				Computed:    true,
+			},
+			"visibility": {
+				Type:        schema.TypeString,
+				Description: "The visibility of the security group",
+				Computed:    true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the security group is active",
+				Computed:    true,
+			},
+			"cloud_ids": {
+				Type:        schema.TypeList,
+				Description: "The ids of the clouds the security group is scoped to",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"external_ids": {
+				Type:        schema.TypeList,
+				Description: "The ids of the security group in each of the clouds it is scoped to",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+	id := d.Get("id").(int)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == 0 && name != "" {
+		resp, err = client.FindSecurityGroupByName(name)
+	} else if id != 0 {
+		resp, err = client.GetSecurityGroup(int64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Security group cannot be read without name or id")
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %v", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetSecurityGroupResult)
+	securityGroup := result.SecurityGroup
+	if securityGroup != nil {
+		d.SetId(int64ToString(securityGroup.ID))
+		d.Set("name", securityGroup.Name)
+		d.Set("description", securityGroup.Description)
+		d.Set("visibility", securityGroup.Visibility)
+		d.Set("active", securityGroup.Active)
+		var cloudIds []int64
+		var externalIds []string
+		for _, location := range securityGroup.Locations {
+			cloudIds = append(cloudIds, location.Zone.ID)
+			externalIds = append(externalIds, location.Externalid)
+		}
+		d.Set("cloud_ids", cloudIds)
+		d.Set("external_ids", externalIds)
+	} else {
+		return diag.Errorf("Security group not found in response data.") // should not happen
+	}
+	return diags
+}
@@ -0,0 +1,422 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sort"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMorpheusUserEffectivePermissions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides the effective permissions of a Morpheus user, the permissions of all roles assigned to the user are merged with the most permissive access level granted by any of the roles taking precedence. The merged access is capped by the access granted by the role of the tenant of the user.",
+		ReadContext: dataSourceMorpheusUserEffectivePermissionsRead,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the Morpheus user",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"username"},
+			},
+			"username": {
+				Type:          schema.TypeString,
+				Description:   "The username of the Morpheus user",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"user_id"},
+			},
+			"role_ids": {
+				Type:        schema.TypeList,
+				Description: "The ids of the roles assigned to the user",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"tenant_role_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the role of the tenant of the user, the role caps the access granted by the roles of the user",
+				Computed:    true,
+			},
+			"feature_permissions": {
+				Type:        schema.TypeList,
+				Description: "The effective feature permissions of the user",
+				Computed:    true,
+				Elem:        effectivePermissionSchema("code", schema.TypeString, "The code of the feature permission"),
+			},
+			"cloud_permissions": {
+				Type:        schema.TypeList,
+				Description: "The effective cloud permissions of the user",
+				Computed:    true,
+				Elem:        effectivePermissionSchema("id", schema.TypeInt, "The id of the cloud"),
+			},
+			"group_permissions": {
+				Type:        schema.TypeList,
+				Description: "The effective group permissions of the user",
+				Computed:    true,
+				Elem:        effectivePermissionSchema("id", schema.TypeInt, "The id of the group"),
+			},
+			"instance_type_permissions": {
+				Type:        schema.TypeList,
+				Description: "The effective instance type permissions of the user",
+				Computed:    true,
+				Elem:        effectivePermissionSchema("id", schema.TypeInt, "The id of the instance type"),
+			},
+			"catalog_item_type_permissions": {
+				Type:        schema.TypeList,
+				Description: "The effective catalog item type permissions of the user",
+				Computed:    true,
+				Elem:        effectivePermissionSchema("id", schema.TypeInt, "The id of the catalog item type"),
+			},
+		},
+	}
+}
+
+func effectivePermissionSchema(key string, keyType schema.ValueType, keyDescription string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			key: {
+				Type:        keyType,
+				Description: keyDescription,
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the permission",
+				Computed:    true,
+			},
+			"access": {
+				Type:        schema.TypeString,
+				Description: "The effective level of access granted to the user",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// permissionAccessRank orders the access levels from the least to the most permissive, it
+// ranks the access levels of the features that do not report their own access levels
+var permissionAccessRank = map[string]int{
+	"":               0,
+	"none":           0,
+	"no":             0,
+	"read":           1,
+	"view":           1,
+	"listfiles":      1,
+	"user":           2,
+	"group":          3,
+	"provision":      4,
+	"managerules":    4,
+	"rolemappings":   4,
+	"yes":            5,
+	"full":           5,
+	"full_decrypted": 6,
+}
+
+type effectivePermission struct {
+	Name   string
+	Access string
+}
+
+// permissionObject is a cloud or group the roles may grant access to through their global access
+type permissionObject struct {
+	ID   int64
+	Name string
+}
+
+// effectivePermissions holds the access granted to each feature, cloud, group, instance
+// type and catalog item type by one or more roles
+type effectivePermissions struct {
+	features         map[string]effectivePermission
+	clouds           map[int64]effectivePermission
+	groups           map[int64]effectivePermission
+	instanceTypes    map[int64]effectivePermission
+	catalogItemTypes map[int64]effectivePermission
+	// featureAccessTypes are the access levels of each feature from the least to the most permissive
+	featureAccessTypes map[string][]string
+}
+
+func newEffectivePermissions(featureAccessTypes map[string][]string) *effectivePermissions {
+	return &effectivePermissions{
+		features:           make(map[string]effectivePermission),
+		clouds:             make(map[int64]effectivePermission),
+		groups:             make(map[int64]effectivePermission),
+		instanceTypes:      make(map[int64]effectivePermission),
+		catalogItemTypes:   make(map[int64]effectivePermission),
+		featureAccessTypes: featureAccessTypes,
+	}
+}
+
+// featureRank ranks the access level by its position in the access levels of the feature
+func (p *effectivePermissions) featureRank(code string, access string) int {
+	for i, accessType := range p.featureAccessTypes[code] {
+		if accessType == access {
+			return i
+		}
+	}
+	return permissionAccessRank[access]
+}
+
+// merge adds the permissions of the role, keeping the most permissive access. The clouds and
+// groups the role does not list are granted the global access of the role, if it grants any
+func (p *effectivePermissions) merge(role *morpheus.GetRoleResult, clouds []permissionObject, groups []permissionObject) {
+	for _, feature := range role.FeaturePermissions {
+		code := feature.Code
+		mergeEffectivePermission(p.features, code, feature.Name, feature.Access, "none", func(access string) int { return p.featureRank(code, access) })
+	}
+	listedClouds := make(map[int64]bool)
+	for _, cloud := range role.Zones {
+		listedClouds[cloud.ID] = true
+		mergeEffectivePermission(p.clouds, cloud.ID, cloud.Name, cloud.Access, role.GlobalZoneAccess, objectAccessRank)
+	}
+	for _, cloud := range clouds {
+		if !listedClouds[cloud.ID] && objectAccessRank(role.GlobalZoneAccess) > 0 {
+			mergeEffectivePermission(p.clouds, cloud.ID, cloud.Name, role.GlobalZoneAccess, "none", objectAccessRank)
+		}
+	}
+	listedGroups := make(map[int64]bool)
+	for _, group := range role.Sites {
+		listedGroups[group.ID] = true
+		mergeEffectivePermission(p.groups, group.ID, group.Name, group.Access, role.GlobalSiteAccess, objectAccessRank)
+	}
+	for _, group := range groups {
+		if !listedGroups[group.ID] && objectAccessRank(role.GlobalSiteAccess) > 0 {
+			mergeEffectivePermission(p.groups, group.ID, group.Name, role.GlobalSiteAccess, "none", objectAccessRank)
+		}
+	}
+	for _, instanceType := range role.InstanceTypePermissions {
+		mergeEffectivePermission(p.instanceTypes, instanceType.ID, instanceType.Name, instanceType.Access, role.GlobalInstanceTypeAccess, objectAccessRank)
+	}
+	for _, catalogItemType := range role.CatalogItemTypePermissions {
+		mergeEffectivePermission(p.catalogItemTypes, catalogItemType.ID, catalogItemType.Name, catalogItemType.Access, role.GlobalCatalogItemTypeAccess, objectAccessRank)
+	}
+}
+
+// capTo lowers the access of each permission to the access granted by the tenant role,
+// permissions the tenant role does not list are capped by its global access level
+func (p *effectivePermissions) capTo(tenantRole *morpheus.GetRoleResult) {
+	ceiling := newEffectivePermissions(p.featureAccessTypes)
+	ceiling.merge(tenantRole, nil, nil)
+	for code, permission := range p.features {
+		maxAccess := "none"
+		if tenantPermission, ok := ceiling.features[code]; ok && tenantPermission.Access != "" {
+			maxAccess = tenantPermission.Access
+		}
+		if p.featureRank(code, permission.Access) > p.featureRank(code, maxAccess) {
+			permission.Access = maxAccess
+			p.features[code] = permission
+		}
+	}
+	capEffectivePermissions(p.clouds, ceiling.clouds, tenantRole.GlobalZoneAccess)
+	capEffectivePermissions(p.groups, ceiling.groups, tenantRole.GlobalSiteAccess)
+	capEffectivePermissions(p.instanceTypes, ceiling.instanceTypes, tenantRole.GlobalInstanceTypeAccess)
+	capEffectivePermissions(p.catalogItemTypes, ceiling.catalogItemTypes, tenantRole.GlobalCatalogItemTypeAccess)
+}
+
+// objectAccessRank ranks the access levels of clouds, groups, instance types and catalog item types
+func objectAccessRank(access string) int {
+	return permissionAccessRank[access]
+}
+
+// mergeEffectivePermission keeps the most permissive access granted to the permission,
+// access levels of default fall back to the global access level of the role
+func mergeEffectivePermission[K comparable](permissions map[K]effectivePermission, key K, name string, access string, globalAccess string, rank func(string) int) {
+	if access == "" || access == "default" {
+		access = globalAccess
+	}
+	if current, ok := permissions[key]; ok && rank(current.Access) >= rank(access) {
+		return
+	}
+	permissions[key] = effectivePermission{Name: name, Access: access}
+}
+
+func capEffectivePermissions(permissions map[int64]effectivePermission, ceiling map[int64]effectivePermission, globalAccess string) {
+	for key, permission := range permissions {
+		maxAccess := globalAccess
+		if tenantPermission, ok := ceiling[key]; ok {
+			maxAccess = tenantPermission.Access
+		}
+		if maxAccess == "" {
+			maxAccess = "none"
+		}
+		if objectAccessRank(permission.Access) > objectAccessRank(maxAccess) {
+			permission.Access = maxAccess
+			permissions[key] = permission
+		}
+	}
+}
+
+// listPermissionObjects returns the clouds and groups of the appliance
+func listPermissionObjects(client *morpheus.Client) ([]permissionObject, []permissionObject, error) {
+	req := &morpheus.Request{
+		QueryParams: map[string]string{
+			"max": "10000",
+		},
+	}
+	resp, err := client.ListClouds(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return nil, nil, err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	var clouds []permissionObject
+	if result := resp.Result.(*morpheus.ListCloudsResult); result.Clouds != nil {
+		for _, cloud := range *result.Clouds {
+			clouds = append(clouds, permissionObject{ID: cloud.ID, Name: cloud.Name})
+		}
+	}
+
+	resp, err = client.ListGroups(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return nil, nil, err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	var groups []permissionObject
+	if result := resp.Result.(*morpheus.ListGroupsResult); result.Groups != nil {
+		for _, group := range *result.Groups {
+			groups = append(groups, permissionObject{ID: group.ID, Name: group.Name})
+		}
+	}
+	return clouds, groups, nil
+}
+
+func dataSourceMorpheusUserEffectivePermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	username := d.Get("username").(string)
+	id := d.Get("user_id").(int)
+
+	// lookup by username if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == 0 && username != "" {
+		resp, err = client.FindUserByExactName(username)
+	} else if id != 0 {
+		resp, err = client.GetUser(int64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("User cannot be read without username or user_id")
+	}
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	user := resp.Result.(*morpheus.GetUserResult).User
+	if user == nil || user.ID == 0 {
+		if id != 0 {
+			return diag.Errorf("User %d not found", id)
+		}
+		return diag.Errorf("User %s not found", username)
+	}
+
+	// The clouds and groups are listed for the roles granting access to them through their global access
+	clouds, groups, err := listPermissionObjects(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var roleIds []int64
+	var roles []*morpheus.GetRoleResult
+	featureAccessTypes := make(map[string][]string)
+	for _, userRole := range user.Roles {
+		roleIds = append(roleIds, userRole.ID)
+		resp, err := client.GetRole(userRole.ID, &morpheus.Request{})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		roles = append(roles, resp.Result.(*morpheus.GetRoleResult))
+
+		// The access levels of each feature are reported with the feature permission catalog of the role
+		var definitions PermissionDefinitionsPayload
+		if err := json.Unmarshal(resp.Body, &definitions); err != nil {
+			return diag.FromErr(err)
+		}
+		for _, feature := range definitions.FeaturePermissions {
+			if len(feature.AccessTypes) > 0 {
+				featureAccessTypes[feature.Code] = feature.AccessTypes
+			}
+		}
+	}
+	permissions := newEffectivePermissions(featureAccessTypes)
+	for _, role := range roles {
+		permissions.merge(role, clouds, groups)
+	}
+
+	// The roles of the user can not grant more access than the role of their tenant
+	tenantId := user.Account.ID
+	if tenantId == 0 {
+		tenantId = user.AccountID
+	}
+	resp, err = client.GetTenant(tenantId, &morpheus.Request{})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	tenant := resp.Result.(*morpheus.GetTenantResult).Tenant
+	if tenant == nil {
+		return diag.Errorf("read operation: tenant %d not found in response data", tenantId) // should not happen
+	}
+	if tenant.Role.ID != 0 {
+		resp, err = client.GetRole(tenant.Role.ID, &morpheus.Request{})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		permissions.capTo(resp.Result.(*morpheus.GetRoleResult))
+	}
+
+	var featurePermissions []map[string]interface{}
+	for code, permission := range permissions.features {
+		featurePermissions = append(featurePermissions, map[string]interface{}{
+			"code":   code,
+			"name":   permission.Name,
+			"access": permission.Access,
+		})
+	}
+	sort.Slice(featurePermissions, func(i, j int) bool {
+		return featurePermissions[i]["code"].(string) < featurePermissions[j]["code"].(string)
+	})
+
+	d.SetId(int64ToString(user.ID))
+	d.Set("user_id", user.ID)
+	d.Set("username", user.Username)
+	d.Set("role_ids", roleIds)
+	d.Set("tenant_role_id", tenant.Role.ID)
+	d.Set("feature_permissions", featurePermissions)
+	d.Set("cloud_permissions", flattenEffectivePermissions(permissions.clouds))
+	d.Set("group_permissions", flattenEffectivePermissions(permissions.groups))
+	d.Set("instance_type_permissions", flattenEffectivePermissions(permissions.instanceTypes))
+	d.Set("catalog_item_type_permissions", flattenEffectivePermissions(permissions.catalogItemTypes))
+	return diags
+}
+
+func flattenEffectivePermissions(permissions map[int64]effectivePermission) []map[string]interface{} {
+	var ids []int64
+	for id := range permissions {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	var result []map[string]interface{}
+	for _, id := range ids {
+		result = append(result, map[string]interface{}{
+			"id":     id,
+			"name":   permissions[id].Name,
+			"access": permissions[id].Access,
+		})
+	}
+	return result
+}
@@ -0,0 +1,108 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type cypherSecretEphemeralResource struct {
+	client *morpheus.Client
+}
+
+type cypherSecretEphemeralResourceModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+	Ttl   types.Int64  `tfsdk:"ttl"`
+}
+
+func newCypherSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &cypherSecretEphemeralResource{}
+}
+
+func (r *cypherSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cypher_secret"
+}
+
+func (r *cypherSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a Morpheus cypher secret ephemeral resource, the value of the secret is read without being stored in the plan or state",
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				Description: "The path of the cypher secret, excluding the secret prefix",
+				Required:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "The cypher secret value",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"ttl": schema.Int64Attribute{
+				Description: "The time to live of the cypher secret",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *cypherSecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// The provider data is not set until the provider is configured
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*morpheus.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *morpheus.Client, got %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *cypherSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured provider", "the cypher secret can not be read before the provider is configured")
+		return
+	}
+
+	var data cypherSecretEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretPath := fmt.Sprintf("secret/%s", data.Key.ValueString())
+	apiResp, err := r.client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/%s", morpheus.CypherPath, secretPath),
+		Result: &LocalGetCypherResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", apiResp, err)
+		resp.Diagnostics.AddError("Unable to read cypher secret", fmt.Sprintf("cypher %s: %s", secretPath, err))
+		return
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", apiResp)
+
+	result := apiResp.Result.(*LocalGetCypherResult)
+	if result.Type == "object" {
+		jsonPayload, _ := json.Marshal(result.Data)
+		data.Value = types.StringValue(string(jsonPayload))
+	} else {
+		value, ok := result.Data.(string)
+		if !ok {
+			resp.Diagnostics.AddError("Unable to read cypher secret", fmt.Sprintf("unexpected value of type %T for cypher %s", result.Data, secretPath))
+			return
+		}
+		data.Value = types.StringValue(value)
+	}
+	data.Ttl = types.Int64Value(result.LeaseDuration)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
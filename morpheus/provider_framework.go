@@ -0,0 +1,120 @@
+package morpheus
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// frameworkProvider serves the ephemeral resources, which are only supported by the
+// Terraform plugin framework, next to the resources of the SDK provider. The muxed
+// providers must share their schema so it mirrors the schema of Provider
+type frameworkProvider struct{}
+
+type frameworkProviderModel struct {
+	Url             types.String `tfsdk:"url"`
+	AccessToken     types.String `tfsdk:"access_token"`
+	TenantSubdomain types.String `tfsdk:"tenant_subdomain"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	Secure          types.Bool   `tfsdk:"secure"`
+}
+
+// FrameworkProvider returns the provider serving the ephemeral resources
+func FrameworkProvider() provider.Provider {
+	return &frameworkProvider{}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "morpheus"
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	// The SDK provider only reports the url as required when it is not set in the environment
+	urlRequired := os.Getenv("MORPHEUS_API_URL") == ""
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Description: "The URL of the Morpheus Data Appliance where requests will be directed.",
+				Required:    urlRequired,
+				Optional:    !urlRequired,
+			},
+			"access_token": schema.StringAttribute{
+				Description: "Access Token of Morpheus user. This can be used instead of authenticating with Username and Password.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"tenant_subdomain": schema.StringAttribute{
+				Description: "The tenant subdomain used for authentication",
+				Optional:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Username of Morpheus user for authentication",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password of Morpheus user for authentication",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"secure": schema.BoolAttribute{
+				Description: `Allow the provider to enable certificate verification. If omitted, default value is "false".`,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data frameworkProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The arguments fall back to the same environment variables as the SDK provider
+	secure, _ := strconv.ParseBool(os.Getenv("MORPHEUS_API_SECURE"))
+	if !data.Secure.IsNull() {
+		secure = data.Secure.ValueBool()
+	}
+	config := Config{
+		Url:             frameworkProviderString(data.Url, "MORPHEUS_API_URL"),
+		AccessToken:     frameworkProviderString(data.AccessToken, "MORPHEUS_API_TOKEN"),
+		TenantSubdomain: frameworkProviderString(data.TenantSubdomain, "MORPHEUS_API_TENANT"),
+		Username:        frameworkProviderString(data.Username, "MORPHEUS_API_USERNAME"),
+		Password:        frameworkProviderString(data.Password, "MORPHEUS_API_PASSWORD"),
+		Insecure:        !secure,
+	}
+
+	// The warnings of the client are already reported by the SDK provider
+	client, _ := config.Client()
+	resp.EphemeralResourceData = client
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newCypherSecretEphemeralResource,
+	}
+}
+
+func frameworkProviderString(value types.String, envVar string) string {
+	if value.IsNull() || value.IsUnknown() {
+		return os.Getenv(envVar)
+	}
+	return value.ValueString()
+}
@@ -0,0 +1,387 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceApp() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus app resource, the app is provisioned from an app blueprint",
+		CreateContext: resourceAppCreate,
+		ReadContext:   resourceAppRead,
+		UpdateContext: resourceAppUpdate,
+		DeleteContext: resourceAppDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the app",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the app",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the app",
+				Optional:    true,
+				Computed:    true,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "The organization labels associated with the app",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"blueprint_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the app blueprint the app is provisioned from",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the group the app is provisioned into",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"default_cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud the tiers of the app are provisioned into unless a tier config specifies a cloud",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Description: "The code of the environment the app is assigned to",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tier": {
+				Type:        schema.TypeList,
+				Description: "The config overrides of the tiers of the app blueprint",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the tier of the app blueprint",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"config": {
+							Type:             schema.TypeString,
+							Description:      "The config (JSON) merged into the tier of the app blueprint, i.e. the instances of the tier and their cloud, plan and options",
+							Required:         true,
+							ForceNew:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressEquivalentJsonDiffs,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the app",
+				Computed:    true,
+			},
+			"instance_ids": {
+				Type:        schema.TypeList,
+				Description: "The IDs of the member instances of the app",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"tier_instances": {
+				Type:        schema.TypeList,
+				Description: "The member instances of the app grouped by tier",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the tier",
+							Computed:    true,
+						},
+						"instance_ids": {
+							Type:        schema.TypeList,
+							Description: "The IDs of the instances of the tier",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	app := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"labels":      appLabelsPayload(d),
+		"blueprintId": d.Get("blueprint_id").(int),
+		"group": map[string]interface{}{
+			"id": d.Get("group_id").(int),
+		},
+	}
+	if d.Get("environment").(string) != "" {
+		app["environment"] = d.Get("environment").(string)
+	}
+	if d.Get("default_cloud_id").(int) != 0 {
+		app["defaultCloud"] = map[string]interface{}{
+			"id": d.Get("default_cloud_id").(int),
+		}
+	}
+
+	tiers := make(map[string]interface{})
+	for _, item := range d.Get("tier").([]interface{}) {
+		tier := item.(map[string]interface{})
+		var config map[string]interface{}
+		if err := json.Unmarshal([]byte(tier["config"].(string)), &config); err != nil {
+			return diag.Errorf("tier %s: %s", tier["name"].(string), err)
+		}
+		tiers[tier["name"].(string)] = config
+	}
+	if len(tiers) > 0 {
+		app["tiers"] = tiers
+	}
+
+	req := &morpheus.Request{
+		Body: app,
+	}
+	resp, err := client.CreateApp(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateAppResult)
+	if result.App == nil {
+		return diag.Errorf("create operation: app not found in response data: %s", result.Message) // should not happen
+	}
+	appId := result.App.ID
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(appId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"provisioning", "starting", "stopping", "pending"},
+		Target:  []string{"running", "failed", "warning", "denied", "cancelled", "suspended"},
+		Refresh: func() (interface{}, string, error) {
+			appDetails, err := client.GetApp(appId, &morpheus.Request{})
+			if err != nil {
+				return "", "", err
+			}
+			result := appDetails.Result.(*morpheus.GetAppResult)
+			return result, appTiersStatus(result.App), nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		MinTimeout:   30 * time.Second,
+		Delay:        1 * time.Minute,
+		PollInterval: 30 * time.Second,
+	}
+
+	// Wait, catching any errors
+	appDetails, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error creating app: %s", err)
+	}
+	if status := appTiersStatus(appDetails.(*morpheus.GetAppResult).App); status != "running" {
+		resourceAppRead(ctx, d, meta)
+		return diag.Errorf("app %d finished provisioning with status %s", appId, status)
+	}
+
+	resourceAppRead(ctx, d, meta)
+	return diags
+}
+
+func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.GetApp(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetAppResult)
+	app := result.App
+	if app == nil {
+		return diag.Errorf("read operation: app not found in response data") // should not happen
+	}
+
+	var instanceIds []int64
+	var tierInstances []map[string]interface{}
+	for _, appTier := range app.AppTiers {
+		var tierInstanceIds []int64
+		for _, appInstance := range appTier.AppInstances {
+			tierInstanceIds = append(tierInstanceIds, appInstance.Instance.ID)
+			instanceIds = append(instanceIds, appInstance.Instance.ID)
+		}
+		tierInstances = append(tierInstances, map[string]interface{}{
+			"name":         appTier.Tier.Name,
+			"instance_ids": tierInstanceIds,
+		})
+	}
+
+	d.SetId(int64ToString(app.ID))
+	d.Set("name", app.Name)
+	d.Set("description", app.Description)
+	d.Set("labels", app.Labels)
+	d.Set("blueprint_id", app.Blueprint.Id)
+	d.Set("group_id", app.Group.Id)
+	d.Set("environment", app.Environment)
+	d.Set("status", app.Status)
+	d.Set("instance_ids", instanceIds)
+	d.Set("tier_instances", tierInstances)
+	return diags
+}
+
+func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"app": map[string]interface{}{
+				"name":        d.Get("name").(string),
+				"description": d.Get("description").(string),
+				"labels":      appLabelsPayload(d),
+				"environment": d.Get("environment").(string),
+			},
+		},
+	}
+	resp, err := client.UpdateApp(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return resourceAppRead(ctx, d, meta)
+}
+
+func resourceAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{
+		QueryParams: map[string]string{
+			"removeInstances": "on",
+		},
+	}
+	if USE_FORCE {
+		req.QueryParams["force"] = "on"
+	}
+	resp, err := client.DeleteApp(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// The member instances are torn down before the app is removed
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"200"},
+		Target:  []string{"404"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetApp(toInt64(id), &morpheus.Request{})
+			if err != nil {
+				if resp != nil {
+					return resp, strconv.Itoa(resp.StatusCode), nil
+				}
+				return "", "", err
+			}
+			return resp, strconv.Itoa(resp.StatusCode), nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		MinTimeout:   10 * time.Second,
+		Delay:        10 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for app %s to be deleted: %s", id, err)
+	}
+
+	d.SetId("")
+	return diags
+}
+
+func appLabelsPayload(d *schema.ResourceData) []string {
+	labelsPayload := make([]string, 0)
+	if attr, ok := d.GetOk("labels"); ok {
+		for _, s := range attr.(*schema.Set).List() {
+			labelsPayload = append(labelsPayload, s.(string))
+		}
+	}
+	return labelsPayload
+}
+
+// appTiersStatus returns the status of the app, an app is only running once the
+// instances of all of its tiers are running
+func appTiersStatus(app *morpheus.App) string {
+	if app == nil {
+		return ""
+	}
+	for _, appTier := range app.AppTiers {
+		for _, appInstance := range appTier.AppInstances {
+			switch status := appInstance.Instance.Status; status {
+			case "running", "":
+				continue
+			default:
+				return status
+			}
+		}
+	}
+	if app.Status == "" {
+		return "pending"
+	}
+	return app.Status
+}
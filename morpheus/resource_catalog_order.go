@@ -0,0 +1,322 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CatalogInventoryItemsPath is the API endpoint of the ordered catalog items, the sdk
+// reads and deletes the inventory items from the catalog types and cart paths
+const CatalogInventoryItemsPath = "/api/catalog/items"
+
+func resourceCatalogOrder() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus catalog order resource, the catalog item is ordered when the resource is created and the provisioned instance, app or workflow execution is deleted with the resource",
+		CreateContext: resourceCatalogOrderCreate,
+		ReadContext:   resourceCatalogOrderRead,
+		DeleteContext: resourceCatalogOrderDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the ordered catalog inventory item",
+				Computed:    true,
+			},
+			"catalog_item_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the instance, app blueprint or workflow catalog item to order",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Description: "The option values of the order keyed by the field name of the option types of the catalog item, values that are JSON objects or lists are decoded",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"order_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the catalog order",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the ordered catalog inventory item",
+				Computed:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The type of the resource provisioned by the order (instance, app, execution)",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the ordered catalog inventory item",
+				Computed:    true,
+			},
+			"status_message": {
+				Type:        schema.TypeString,
+				Description: "The status message of the ordered catalog inventory item",
+				Computed:    true,
+			},
+			"instance_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the instance provisioned by an instance catalog item",
+				Computed:    true,
+			},
+			"app_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the app provisioned by an app blueprint catalog item",
+				Computed:    true,
+			},
+			"app_instance_ids": {
+				Type:        schema.TypeList,
+				Description: "The IDs of the instances of the app provisioned by an app blueprint catalog item",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"execution_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the job execution started by a workflow catalog item",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceCatalogOrderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	config := make(map[string]interface{})
+	for key, value := range d.Get("config").(map[string]interface{}) {
+		config[key] = value
+		trimmed := strings.TrimSpace(value.(string))
+		if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+			var decoded interface{}
+			if err := json.Unmarshal([]byte(trimmed), &decoded); err == nil {
+				config[key] = decoded
+			}
+		}
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"order": map[string]interface{}{
+				"items": []map[string]interface{}{
+					{
+						"type": map[string]interface{}{
+							"id": d.Get("catalog_item_id").(int),
+						},
+						"config": config,
+					},
+				},
+			},
+		},
+	}
+	resp, err := client.PlaceCatalogOrder(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.PlaceCatalogOrderResult)
+	if !result.Success && result.Msg != "" {
+		return diag.Errorf("error placing catalog order: %s", result.Msg)
+	}
+	if len(result.Order.Items) == 0 {
+		return diag.Errorf("create operation: ordered item not found in response data") // should not happen
+	}
+	itemId := result.Order.Items[0].ID
+	// Successfully ordered the item, now set id
+	d.SetId(int64ToString(itemId))
+	d.Set("order_id", result.Order.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"ordered", "pending", "validating", "provisioning", "in-progress", "in_progress", "queued", "running"},
+		Target:  []string{"active", "complete", "completed", "failed", "error", "warning", "denied", "cancelled", "expired"},
+		Refresh: func() (interface{}, string, error) {
+			item, err := getCatalogInventoryItem(client, itemId)
+			if err != nil {
+				return "", "", err
+			}
+			return item, catalogInventoryItemStatus(item), nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		MinTimeout:   10 * time.Second,
+		Delay:        10 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+
+	// Wait, catching any errors
+	item, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for catalog order %d: %s", result.Order.ID, err)
+	}
+
+	// Store the outcome before reporting a failure so the provisioned resources are deleted with the order
+	setCatalogOrderData(d, item.(*morpheus.InventoryItem))
+	switch status := catalogInventoryItemStatus(item.(*morpheus.InventoryItem)); status {
+	case "active", "complete", "completed":
+		return nil
+	default:
+		return diag.Errorf("catalog order %d finished with status %s: %s", result.Order.ID, status, item.(*morpheus.InventoryItem).StatusMessage)
+	}
+}
+
+func resourceCatalogOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/%s", CatalogInventoryItemsPath, id),
+		Result: &morpheus.GetCatalogInventoryItemResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	item := resp.Result.(*morpheus.GetCatalogInventoryItemResult).CatalogInventoryItem
+	if item == nil {
+		return diag.Errorf("read operation: catalog inventory item not found in response data") // should not happen
+	}
+	setCatalogOrderData(d, item)
+	return diags
+}
+
+func resourceCatalogOrderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// Deleting the inventory item deletes the instance or app it provisioned
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("%s/%s", CatalogInventoryItemsPath, id),
+		Result: &morpheus.DeleteCatalogInventoryItemResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"200"},
+		Target:  []string{"404"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Execute(&morpheus.Request{
+				Method: "GET",
+				Path:   fmt.Sprintf("%s/%s", CatalogInventoryItemsPath, id),
+			})
+			if err != nil {
+				if resp != nil {
+					return resp, strconv.Itoa(resp.StatusCode), nil
+				}
+				return "", "", err
+			}
+			return resp, strconv.Itoa(resp.StatusCode), nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		MinTimeout:   5 * time.Second,
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for catalog inventory item %s to be deleted: %s", id, err)
+	}
+
+	d.SetId("")
+	return diags
+}
+
+func getCatalogInventoryItem(client *morpheus.Client, id int64) (*morpheus.InventoryItem, error) {
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/%d", CatalogInventoryItemsPath, id),
+		Result: &morpheus.GetCatalogInventoryItemResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return nil, err
+	}
+	item := resp.Result.(*morpheus.GetCatalogInventoryItemResult).CatalogInventoryItem
+	if item == nil {
+		return nil, fmt.Errorf("catalog inventory item %d not found in response data", id)
+	}
+	return item, nil
+}
+
+// catalogInventoryItemStatus returns the normalized status of the inventory item, the
+// status of a workflow order follows its execution
+func catalogInventoryItemStatus(item *morpheus.InventoryItem) string {
+	status := strings.ToLower(item.Status)
+	if item.Execution.ID != 0 {
+		if executionStatus := strings.ToLower(item.Execution.Status); executionStatus != "" && status != "failed" {
+			return executionStatus
+		}
+	}
+	return status
+}
+
+func setCatalogOrderData(d *schema.ResourceData, item *morpheus.InventoryItem) {
+	d.Set("name", item.Name)
+	d.Set("status", item.Status)
+	d.Set("status_message", item.StatusMessage)
+
+	var appInstanceIds []int64
+	for _, instance := range item.App.Instances {
+		appInstanceIds = append(appInstanceIds, instance.ID)
+	}
+	switch {
+	case item.App.ID != 0:
+		d.Set("type", "app")
+	case item.Instance.ID != 0:
+		d.Set("type", "instance")
+	case item.Execution.ID != 0:
+		d.Set("type", "execution")
+	}
+	d.Set("instance_id", item.Instance.ID)
+	d.Set("app_id", item.App.ID)
+	d.Set("app_instance_ids", appInstanceIds)
+	d.Set("execution_id", item.Execution.ID)
+}
@@ -22,6 +22,7 @@ func resourceClusterLayout() *schema.Resource {
 		ReadContext:   resourceClusterLayoutRead,
 		UpdateContext: resourceClusterLayoutUpdate,
 		DeleteContext: resourceClusterLayoutDelete,
+		CustomizeDiff: clusterLayoutNodePoolsCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -203,6 +204,92 @@ func resourceClusterLayout() *schema.Resource {
 	}
 }
 
+// clusterLayoutNodePoolsCustomizeDiff resolves the node types referenced by the master and worker
+// node pools and ensures they match the technology (provision type) of the layout and that the
+// cluster type receives the nodes it requires, so invalid layouts fail during plan instead of apply
+func clusterLayoutNodePoolsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("master_node_pool", "worker_node_pool", "provision_type_id", "cluster_type_id") {
+		return nil
+	}
+
+	// Values computed from other resources are not known until apply
+	if !d.NewValueKnown("master_node_pool") || !d.NewValueKnown("worker_node_pool") || !d.NewValueKnown("provision_type_id") {
+		return nil
+	}
+
+	client := meta.(*morpheus.Client)
+	provisionTypeId := int64(d.Get("provision_type_id").(int))
+
+	var problems []string
+	nodeTypes := make(map[int64]*morpheus.NodeType)
+	poolCounts := make(map[string]int)
+	for _, pool := range []string{"master_node_pool", "worker_node_pool"} {
+		for i, item := range d.Get(pool).([]interface{}) {
+			nodePool := item.(map[string]interface{})
+			poolCounts[pool] += nodePool["count"].(int)
+			nodeTypeId := int64(nodePool["node_type_id"].(int))
+			if nodeTypeId == 0 {
+				continue
+			}
+
+			nodeType, ok := nodeTypes[nodeTypeId]
+			if !ok {
+				resp, err := client.GetNodeType(nodeTypeId, &morpheus.Request{})
+				if err != nil {
+					if resp != nil && resp.StatusCode == 404 {
+						problems = append(problems, fmt.Sprintf("%s.%d.node_type_id: node type %d does not exist", pool, i, nodeTypeId))
+						continue
+					}
+					log.Printf("API FAILURE: %s - %s", resp, err)
+					return err
+				}
+				result := resp.Result.(*morpheus.GetNodeTypeResult)
+				nodeType = result.NodeType
+				nodeTypes[nodeTypeId] = nodeType
+			}
+
+			if provisionTypeId > 0 && nodeType.ProvisionType.ID != provisionTypeId {
+				problems = append(problems, fmt.Sprintf("%s.%d.node_type_id: node type %d (%s) uses the %s technology but the layout provision type is %d",
+					pool, i, nodeTypeId, nodeType.Name, nodeType.ProvisionType.Name, provisionTypeId))
+			}
+		}
+	}
+
+	// Ensure the cluster type receives the node pools it requires
+	if d.NewValueKnown("cluster_type_id") && d.Get("cluster_type_id").(int) > 0 {
+		clusterTypeId := int64(d.Get("cluster_type_id").(int))
+		resp, err := client.ListClusterTypes(&morpheus.Request{
+			QueryParams: map[string]string{
+				"max": "250",
+			},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+		result := resp.Result.(*morpheus.ListClusterTypesResult)
+		if result.ClusterTypes != nil {
+			for _, clusterType := range *result.ClusterTypes {
+				if clusterType.ID != clusterTypeId {
+					continue
+				}
+				if clusterType.HasMasters && poolCounts["master_node_pool"] < 1 {
+					problems = append(problems, fmt.Sprintf("master_node_pool: the %s cluster type requires at least one master node", clusterType.Name))
+				}
+				if clusterType.HasWorkers && poolCounts["worker_node_pool"] < 1 {
+					problems = append(problems, fmt.Sprintf("worker_node_pool: the %s cluster type requires at least one worker node", clusterType.Name))
+				}
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid cluster layout node pools:\n  %s", strings.Join(problems, "\n  "))
+	}
+
+	return nil
+}
+
 func resourceClusterLayoutCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*morpheus.Client)
 
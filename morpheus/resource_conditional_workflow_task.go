@@ -0,0 +1,335 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceConditionalWorkflowTask() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus conditional workflow task resource",
+		CreateContext: resourceConditionalWorkflowTaskCreate,
+		ReadContext:   resourceConditionalWorkflowTaskRead,
+		UpdateContext: resourceConditionalWorkflowTaskUpdate,
+		DeleteContext: resourceConditionalWorkflowTaskDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the conditional workflow task",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the conditional workflow task",
+				Required:    true,
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Description: "The code of the conditional workflow task",
+				Optional:    true,
+				Computed:    true,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "The organization labels associated with the task (Only supported on Morpheus 5.5.3 or higher)",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"condition_script": {
+				Type:        schema.TypeString,
+				Description: "The javascript condition evaluated to determine which workflow is executed, a truthy result executes the success workflow",
+				Required:    true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					oldPayload := strings.TrimSuffix(old, "\n")
+					newPayload := strings.TrimSuffix(new, "\n")
+					return oldPayload == newPayload
+				},
+				StateFunc: func(val interface{}) string {
+					return strings.TrimSuffix(val.(string), "\n")
+				},
+			},
+			"success_workflow_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the operational workflow executed when the condition is true",
+				Optional:    true,
+				Computed:    true,
+			},
+			"success_workflow_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the operational workflow executed when the condition is true",
+				Optional:    true,
+				Computed:    true,
+			},
+			"failure_workflow_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the operational workflow executed when the condition is false",
+				Optional:    true,
+				Computed:    true,
+			},
+			"failure_workflow_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the operational workflow executed when the condition is false",
+				Optional:    true,
+				Computed:    true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "The visibility of the task (private or public)",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"retryable": {
+				Type:        schema.TypeBool,
+				Description: "Whether to retry the task if there is a failure",
+				Optional:    true,
+				Default:     false,
+			},
+			"retry_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of times to retry the task if there is a failure",
+				Optional:    true,
+				Default:     5,
+			},
+			"retry_delay_seconds": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds to wait between retry attempts",
+				Optional:    true,
+				Default:     10,
+			},
+			"allow_custom_config": {
+				Type:        schema.TypeBool,
+				Description: "Custom configuration data to pass during the execution of the conditional workflow",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceConditionalWorkflowTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"task": conditionalWorkflowTaskPayload(d),
+		},
+	}
+	resp, err := client.CreateTask(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateTaskResult)
+	task := result.Task
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(task.ID))
+	log.Printf("Task ID: %s", int64ToString(task.ID))
+
+	resourceConditionalWorkflowTaskRead(ctx, d, meta)
+	return diags
+}
+
+func resourceConditionalWorkflowTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindTaskByName(name)
+	} else if id != "" {
+		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Task cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetTaskResult)
+	conditionalWorkflowTask := result.Task
+
+	// The sdk does not include the conditional workflow options so parse the body directly
+	var taskPayload ConditionalWorkflowTaskPayload
+	if err := json.Unmarshal(resp.Body, &taskPayload); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(int64ToString(conditionalWorkflowTask.ID))
+	d.Set("name", conditionalWorkflowTask.Name)
+	d.Set("code", conditionalWorkflowTask.Code)
+	d.Set("labels", conditionalWorkflowTask.Labels)
+	d.Set("condition_script", conditionalWorkflowTask.TaskOptions.JsScript)
+	successWorkflowId, err := conditionalWorkflowId(taskPayload.Task.TaskOptions.IfOperationalWorkflowId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("success_workflow_id", successWorkflowId); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("success_workflow_name", taskPayload.Task.TaskOptions.IfOperationalWorkflowName)
+	failureWorkflowId, err := conditionalWorkflowId(taskPayload.Task.TaskOptions.ElseOperationalWorkflowId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("failure_workflow_id", failureWorkflowId); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("failure_workflow_name", taskPayload.Task.TaskOptions.ElseOperationalWorkflowName)
+	d.Set("visibility", conditionalWorkflowTask.Visibility)
+	d.Set("retryable", conditionalWorkflowTask.Retryable)
+	d.Set("retry_count", conditionalWorkflowTask.RetryCount)
+	d.Set("retry_delay_seconds", conditionalWorkflowTask.RetryDelaySeconds)
+	d.Set("allow_custom_config", conditionalWorkflowTask.AllowCustomConfig)
+	return diags
+}
+
+func resourceConditionalWorkflowTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"task": conditionalWorkflowTaskPayload(d),
+		},
+	}
+	resp, err := client.UpdateTask(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateTaskResult)
+	conditionalWorkflowTask := result.Task
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(conditionalWorkflowTask.ID))
+	return resourceConditionalWorkflowTaskRead(ctx, d, meta)
+}
+
+func resourceConditionalWorkflowTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteTask(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func conditionalWorkflowTaskPayload(d *schema.ResourceData) map[string]interface{} {
+	taskOptions := map[string]interface{}{
+		"jsScript":                    d.Get("condition_script").(string),
+		"ifOperationalWorkflowId":     d.Get("success_workflow_id").(int),
+		"ifOperationalWorkflowName":   d.Get("success_workflow_name").(string),
+		"elseOperationalWorkflowId":   d.Get("failure_workflow_id").(int),
+		"elseOperationalWorkflowName": d.Get("failure_workflow_name").(string),
+	}
+
+	labelsPayload := make([]string, 0)
+	if attr, ok := d.GetOk("labels"); ok {
+		for _, s := range attr.(*schema.Set).List() {
+			labelsPayload = append(labelsPayload, s.(string))
+		}
+	}
+
+	return map[string]interface{}{
+		"name":   d.Get("name").(string),
+		"code":   d.Get("code").(string),
+		"labels": labelsPayload,
+		"taskType": map[string]interface{}{
+			"code": "conditionalWorkflow",
+		},
+		"taskOptions":       taskOptions,
+		"executeTarget":     "local",
+		"visibility":        d.Get("visibility"),
+		"retryable":         d.Get("retryable"),
+		"retryCount":        d.Get("retry_count"),
+		"retryDelaySeconds": d.Get("retry_delay_seconds"),
+		"allowCustomConfig": d.Get("allow_custom_config"),
+	}
+}
+
+type ConditionalWorkflowTaskPayload struct {
+	Task struct {
+		TaskOptions struct {
+			IfOperationalWorkflowId     interface{} `json:"ifOperationalWorkflowId"`
+			IfOperationalWorkflowName   string      `json:"ifOperationalWorkflowName"`
+			ElseOperationalWorkflowId   interface{} `json:"elseOperationalWorkflowId"`
+			ElseOperationalWorkflowName string      `json:"elseOperationalWorkflowName"`
+		} `json:"taskOptions"`
+	} `json:"task"`
+}
+
+// conditionalWorkflowId converts a workflow id of the task options, which the API returns
+// as either a number or a string, to an int. 0 is returned when the workflow is not set
+func conditionalWorkflowId(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return int(v), nil
+	case string:
+		if v == "" {
+			return 0, nil
+		}
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, fmt.Errorf("invalid workflow id %q: %s", v, err)
+		}
+		return id, nil
+	default:
+		return 0, fmt.Errorf("invalid workflow id %v", v)
+	}
+}
@@ -0,0 +1,298 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCypherGeneratedSecret() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus cypher generated secret resource, the value is generated by the password, uuid or key cypher engine and exposed as a sensitive attribute. The lease of the secret is renewed by reading the value again with the ttl once it is within renew_before seconds of expiring",
+		CreateContext: resourceCypherGeneratedSecretCreate,
+		ReadContext:   resourceCypherGeneratedSecretRead,
+		UpdateContext: resourceCypherGeneratedSecretUpdate,
+		DeleteContext: resourceCypherGeneratedSecretDelete,
+		CustomizeDiff: resourceCypherGeneratedSecretCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the cypher generated secret",
+				Computed:    true,
+			},
+			"mount": {
+				Type:         schema.TypeString,
+				Description:  "The cypher engine generating the value (password, uuid, key)",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"password", "uuid", "key"}, false),
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Description: "The path of the cypher generated secret, excluding the mount prefix",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"length": {
+				Type:        schema.TypeInt,
+				Description: "The length of the generated password or the bit length of the generated AES key (128, 192, 256), the engine default is used when not set",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Description:  "The lease duration of the cypher generated secret in seconds, the secret does not expire when not set",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"renew_before": {
+				Type:         schema.TypeInt,
+				Description:  "The number of seconds before the lease expires the lease is renewed by reading the value again with the ttl, the lease is not renewed when not set",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Description: "The generated value",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Description: "The time the lease of the cypher generated secret expires (RFC3339)",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// cypherGeneratedSecretPath returns the path the value is generated at, the length
+// is part of the path for the password and key engines
+func cypherGeneratedSecretPath(d interface{ Get(string) interface{} }) string {
+	if length := d.Get("length").(int); length != 0 && d.Get("mount").(string) != "uuid" {
+		return fmt.Sprintf("%s/%d/%s", d.Get("mount").(string), length, d.Get("key").(string))
+	}
+	return fmt.Sprintf("%s/%s", d.Get("mount").(string), d.Get("key").(string))
+}
+
+func resourceCypherGeneratedSecretCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	var problems []string
+	length := diff.Get("length").(int)
+	switch diff.Get("mount").(string) {
+	case "key":
+		if length != 0 && length != 128 && length != 192 && length != 256 {
+			problems = append(problems, fmt.Sprintf("length %d is not a valid AES key bit length (128, 192, 256)", length))
+		}
+	case "uuid":
+		if length != 0 {
+			problems = append(problems, "length cannot be set for the uuid mount")
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid cypher generated secret:\n  %s", strings.Join(problems, "\n  "))
+	}
+
+	// Plan the renewal of the lease once it is within renew_before seconds of expiring
+	if diff.Id() == "" {
+		return nil
+	}
+	expiresAt, _ := diff.GetChange("expires_at")
+	renew, err := cypherGeneratedSecretRenewalDue(expiresAt.(string), diff.Get("renew_before").(int))
+	if err != nil {
+		return err
+	}
+	if renew {
+		log.Printf("Cypher %s expires at %s, renewing the lease", cypherGeneratedSecretPath(diff), expiresAt)
+		return diff.SetNewComputed("expires_at")
+	}
+	return nil
+}
+
+// cypherGeneratedSecretQueryParams returns the ttl of the lease, the ttl is left out when not set
+func cypherGeneratedSecretQueryParams(d *schema.ResourceData) map[string]string {
+	queryParams := make(map[string]string)
+	if ttl := d.Get("ttl").(int); ttl != 0 {
+		queryParams["ttl"] = strconv.Itoa(ttl)
+	}
+	return queryParams
+}
+
+// cypherGeneratedSecretRenewalDue returns whether the lease is within renewBefore seconds
+// of expiring, the lease is not renewed when renewBefore is not set
+func cypherGeneratedSecretRenewalDue(expiresAt string, renewBefore int) (bool, error) {
+	if renewBefore == 0 || expiresAt == "" {
+		return false, nil
+	}
+	expiresAtTime, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false, err
+	}
+	return time.Now().Add(time.Duration(renewBefore) * time.Second).After(expiresAtTime), nil
+}
+
+func resourceCypherGeneratedSecretCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// The value is generated and stored by the engine the first time it is read
+	secretPath := cypherGeneratedSecretPath(d)
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "GET",
+		Path:        fmt.Sprintf("%s/%s", morpheus.CypherPath, secretPath),
+		QueryParams: cypherGeneratedSecretQueryParams(d),
+		Result:      &LocalGetCypherResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*LocalGetCypherResult)
+	if result.Cypher == nil {
+		return diag.Errorf("create operation: cypher not found in response data") // should not happen
+	}
+	// Successfully generated the value, now set id
+	d.SetId(int64ToString(result.Cypher.ID))
+
+	resourceCypherGeneratedSecretRead(ctx, d, meta)
+	return diags
+}
+
+func resourceCypherGeneratedSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// Reading an expired secret generates a new value, the secret is recreated instead
+	if expiresAt := d.Get("expires_at").(string); expiresAt != "" {
+		expiresAtTime, err := time.Parse(time.RFC3339, expiresAt)
+		if err == nil && time.Now().After(expiresAtTime) {
+			log.Printf("Cypher %s expired at %s", cypherGeneratedSecretPath(d), expiresAt)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		}
+	}
+
+	// The ttl is passed so a value generated in place of a deleted secret has the lease of the resource
+	secretPath := cypherGeneratedSecretPath(d)
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "GET",
+		Path:        fmt.Sprintf("%s/%s", morpheus.CypherPath, secretPath),
+		QueryParams: cypherGeneratedSecretQueryParams(d),
+		Result:      &LocalGetCypherResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*LocalGetCypherResult)
+	if result.Cypher == nil {
+		return diag.Errorf("read operation: cypher not found in response data") // should not happen
+	}
+	if int64ToString(result.Cypher.ID) != d.Id() {
+		// The engine generated a new value as the secret expired or has been deleted
+		log.Printf("Cypher %s has been regenerated", secretPath)
+		log.Printf("Forcing recreation of resource")
+		d.SetId("")
+		return diags
+	}
+	if result.Type == "object" {
+		jsonPayload, _ := json.Marshal(result.Data)
+		d.Set("value", string(jsonPayload))
+	} else {
+		value, ok := result.Data.(string)
+		if !ok {
+			return diag.Errorf("read operation: unexpected value of type %T for cypher %s", result.Data, secretPath)
+		}
+		d.Set("value", value)
+	}
+	d.Set("ttl", result.LeaseDuration)
+	if result.Cypher.ExpireDate.IsZero() {
+		d.Set("expires_at", "")
+	} else {
+		d.Set("expires_at", result.Cypher.ExpireDate.UTC().Format(time.RFC3339))
+	}
+	return diags
+}
+
+func resourceCypherGeneratedSecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// The lease is renewed by reading the generated value again with the ttl, a renewal is
+	// planned by marking expires_at unknown
+	if !d.GetRawPlan().GetAttr("expires_at").IsKnown() || d.HasChange("ttl") {
+		secretPath := cypherGeneratedSecretPath(d)
+		resp, err := client.Execute(&morpheus.Request{
+			Method:      "GET",
+			Path:        fmt.Sprintf("%s/%s", morpheus.CypherPath, secretPath),
+			QueryParams: cypherGeneratedSecretQueryParams(d),
+			Result:      &LocalGetCypherResult{},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		// Masking to avoid credential exposure
+		// log.Printf("API RESPONSE: %s", resp)
+
+		result := resp.Result.(*LocalGetCypherResult)
+		if result.Cypher == nil {
+			return diag.Errorf("update operation: cypher not found in response data") // should not happen
+		}
+		if int64ToString(result.Cypher.ID) != d.Id() {
+			return diag.Errorf("cypher %s has been regenerated instead of renewed, the secret is replaced during the next apply", secretPath)
+		}
+	}
+	return resourceCypherGeneratedSecretRead(ctx, d, meta)
+}
+
+func resourceCypherGeneratedSecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := client.DeleteCypher(cypherGeneratedSecretPath(d), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
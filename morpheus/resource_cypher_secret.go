@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -18,6 +19,7 @@ func resourceCypherSecret() *schema.Resource {
 		Description:   "Provides a Morpheus cypher secret resource.",
 		CreateContext: resourceCypherSecretCreate,
 		ReadContext:   resourceCypherSecretRead,
+		UpdateContext: resourceCypherSecretUpdate,
 		DeleteContext: resourceCypherSecretDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -33,11 +35,25 @@ func resourceCypherSecret() *schema.Resource {
 				ForceNew:    true,
 			},
 			"value": {
+				Type:         schema.TypeString,
+				Description:  "The value of the cypher secret",
+				Optional:     true,
+				Sensitive:    true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"value", "value_wo"},
+			},
+			"value_wo": {
 				Type:        schema.TypeString,
-				Description: "The value of the cypher secret",
-				Required:    true,
+				Description: "The write-only value of the cypher secret, the value is not stored in the plan or state (requires Terraform 1.11 or later)",
+				Optional:    true,
 				Sensitive:   true,
-				ForceNew:    true,
+				WriteOnly:   true,
+			},
+			"value_wo_version": {
+				Type:         schema.TypeInt,
+				Description:  "The version of the write-only value, changing the version writes the current value_wo to the cypher secret in place",
+				Optional:     true,
+				RequiredWith: []string{"value_wo"},
 			},
 			"ttl": {
 				Type:        schema.TypeInt,
@@ -59,26 +75,10 @@ func resourceCypherSecretCreate(ctx context.Context, d *schema.ResourceData, met
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	req := &morpheus.Request{
-		Body: map[string]interface{}{
-			"value": d.Get("value").(string),
-		},
-		QueryParams: map[string]string{
-			"ttl":  strconv.Itoa(d.Get("ttl").(int)),
-			"type": "string",
-		},
+	result, diags := writeCypherSecret(client, d)
+	if diags.HasError() {
+		return diags
 	}
-
-	secretPath := fmt.Sprintf("secret/%s", d.Get("key").(string))
-	resp, err := client.CreateCypher(secretPath, req)
-	if err != nil {
-		log.Printf("API FAILURE: %s - %s", resp, err)
-		return diag.FromErr(err)
-	}
-	// Masking to avoid credential exposure
-	// log.Printf("API RESPONSE: %s", resp)
-
-	result := resp.Result.(*morpheus.CreateCypherResult)
 	// Successfully created resource, now set id
 	d.SetId(int64ToString(result.Cypher.ID))
 
@@ -131,6 +131,18 @@ func resourceCypherSecretRead(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceCypherSecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// The write-only value is written again in place when its version changes
+	if d.HasChange("value_wo_version") {
+		if _, diags := writeCypherSecret(client, d); diags.HasError() {
+			return diags
+		}
+	}
+	return resourceCypherSecretRead(ctx, d, meta)
+}
+
 func resourceCypherSecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*morpheus.Client)
 
@@ -153,3 +165,35 @@ func resourceCypherSecretDelete(ctx context.Context, d *schema.ResourceData, met
 	d.SetId("")
 	return diags
 }
+
+// writeCypherSecret writes the value or the write-only value to the cypher secret
+func writeCypherSecret(client *morpheus.Client, d *schema.ResourceData) (*morpheus.CreateCypherResult, diag.Diagnostics) {
+	// The write-only value is only available in the configuration
+	value := d.Get("value").(string)
+	if rawValue, rawDiags := d.GetRawConfigAt(cty.GetAttrPath("value_wo")); rawDiags.HasError() {
+		return nil, rawDiags
+	} else if rawValue.Type().Equals(cty.String) && !rawValue.IsNull() {
+		value = rawValue.AsString()
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"value": value,
+		},
+		QueryParams: map[string]string{
+			"ttl":  strconv.Itoa(d.Get("ttl").(int)),
+			"type": "string",
+		},
+	}
+
+	secretPath := fmt.Sprintf("secret/%s", d.Get("key").(string))
+	resp, err := client.CreateCypher(secretPath, req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return nil, diag.FromErr(err)
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	return resp.Result.(*morpheus.CreateCypherResult), nil
+}
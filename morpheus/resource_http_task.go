@@ -0,0 +1,359 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceHttpTask() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus http task resource",
+		CreateContext: resourceHttpTaskCreate,
+		ReadContext:   resourceHttpTaskRead,
+		UpdateContext: resourceHttpTaskUpdate,
+		DeleteContext: resourceHttpTaskDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the http task",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the http task",
+				Required:    true,
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Description: "The code of the http task",
+				Optional:    true,
+				Computed:    true,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "The organization labels associated with the task (Only supported on Morpheus 5.5.3 or higher)",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"result_type": {
+				Type:         schema.TypeString,
+				Description:  "The expected result type (value, keyValue, json)",
+				ValidateFunc: validation.StringInSlice([]string{"value", "keyValue", "json"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Description:  "The url the http request is sent to",
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"method": {
+				Type:         schema.TypeString,
+				Description:  "The http method of the request (GET, POST, PUT, PATCH, DELETE, HEAD)",
+				Optional:     true,
+				Default:      "GET",
+				ValidateFunc: validation.StringInSlice([]string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD"}, false),
+			},
+			"headers": {
+				Type:        schema.TypeMap,
+				Description: "The http headers sent with the request",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"body": {
+				Type:        schema.TypeString,
+				Description: "The body of the http request",
+				Optional:    true,
+				Computed:    true,
+			},
+			"auth_username": {
+				Type:        schema.TypeString,
+				Description: "The username used for basic authentication of the http request",
+				Optional:    true,
+				Computed:    true,
+			},
+			"auth_password": {
+				Type:        schema.TypeString,
+				Description: "The password used for basic authentication of the http request",
+				Optional:    true,
+				Sensitive:   true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+				Computed: true,
+			},
+			"ignore_ssl_errors": {
+				Type:        schema.TypeBool,
+				Description: "Whether to ignore ssl certificate errors of the requested url",
+				Optional:    true,
+				Default:     false,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "The visibility of the task (private or public)",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"retryable": {
+				Type:        schema.TypeBool,
+				Description: "Whether to retry the task if there is a failure",
+				Optional:    true,
+				Default:     false,
+			},
+			"retry_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of times to retry the task if there is a failure",
+				Optional:    true,
+				Default:     5,
+			},
+			"retry_delay_seconds": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds to wait between retry attempts",
+				Optional:    true,
+				Default:     10,
+			},
+			"allow_custom_config": {
+				Type:        schema.TypeBool,
+				Description: "Custom configuration data to pass during the execution of the http request",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceHttpTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	taskOptions, err := httpTaskOptions(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.Get("auth_password") != "" {
+		taskOptions["webPassword"] = d.Get("auth_password")
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"task": httpTaskPayload(d, taskOptions),
+		},
+	}
+	resp, err := client.CreateTask(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateTaskResult)
+	task := result.Task
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(task.ID))
+	log.Printf("Task ID: %s", int64ToString(task.ID))
+
+	resourceHttpTaskRead(ctx, d, meta)
+	return diags
+}
+
+func resourceHttpTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindTaskByName(name)
+	} else if id != "" {
+		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Task cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetTaskResult)
+	httpTask := result.Task
+
+	d.SetId(int64ToString(httpTask.ID))
+	d.Set("name", httpTask.Name)
+	d.Set("code", httpTask.Code)
+	d.Set("labels", httpTask.Labels)
+	d.Set("result_type", httpTask.ResultType)
+	d.Set("url", httpTask.TaskOptions.WebUrl)
+	d.Set("method", httpTask.TaskOptions.WebMethod)
+	d.Set("body", httpTask.TaskOptions.WebBody)
+	d.Set("auth_username", httpTask.TaskOptions.WebUser)
+	d.Set("auth_password", httpTask.TaskOptions.WebPasswordHash)
+	d.Set("ignore_ssl_errors", httpTask.TaskOptions.IgnoreSSL == "on" || httpTask.TaskOptions.IgnoreSSL == "true")
+
+	var headers []HttpTaskHeader
+	headersPayload := make(map[string]interface{})
+	if httpTask.TaskOptions.WebHeaders != "" {
+		if err := json.Unmarshal([]byte(httpTask.TaskOptions.WebHeaders), &headers); err != nil {
+			log.Printf("Unable to parse the http task headers: %s", err)
+		}
+		for _, header := range headers {
+			headersPayload[header.Name] = header.Value
+		}
+	}
+	d.Set("headers", headersPayload)
+
+	d.Set("visibility", httpTask.Visibility)
+	d.Set("retryable", httpTask.Retryable)
+	d.Set("retry_count", httpTask.RetryCount)
+	d.Set("retry_delay_seconds", httpTask.RetryDelaySeconds)
+	d.Set("allow_custom_config", httpTask.AllowCustomConfig)
+	return diags
+}
+
+func resourceHttpTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	taskOptions, err := httpTaskOptions(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("auth_password") {
+		taskOptions["webPassword"] = d.Get("auth_password")
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"task": httpTaskPayload(d, taskOptions),
+		},
+	}
+	resp, err := client.UpdateTask(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateTaskResult)
+	httpTask := result.Task
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(httpTask.ID))
+	return resourceHttpTaskRead(ctx, d, meta)
+}
+
+func resourceHttpTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteTask(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// httpTaskOptions builds the task options of the http request, the headers are
+// stored by Morpheus as a json encoded list of name and value pairs
+func httpTaskOptions(d *schema.ResourceData) (map[string]interface{}, error) {
+	taskOptions := make(map[string]interface{})
+	taskOptions["webUrl"] = d.Get("url")
+	taskOptions["webMethod"] = d.Get("method")
+	taskOptions["webBody"] = d.Get("body")
+	taskOptions["webUser"] = d.Get("auth_username")
+	if d.Get("ignore_ssl_errors").(bool) {
+		taskOptions["ignoreSSL"] = "on"
+	} else {
+		taskOptions["ignoreSSL"] = nil
+	}
+
+	headers := make([]HttpTaskHeader, 0)
+	for name, value := range d.Get("headers").(map[string]interface{}) {
+		headers = append(headers, HttpTaskHeader{Name: name, Value: value.(string)})
+	}
+	headersPayload, err := json.Marshal(headers)
+	if err != nil {
+		return nil, err
+	}
+	taskOptions["webHeaders"] = string(headersPayload)
+	return taskOptions, nil
+}
+
+func httpTaskPayload(d *schema.ResourceData, taskOptions map[string]interface{}) map[string]interface{} {
+	labelsPayload := make([]string, 0)
+	if attr, ok := d.GetOk("labels"); ok {
+		for _, s := range attr.(*schema.Set).List() {
+			labelsPayload = append(labelsPayload, s.(string))
+		}
+	}
+
+	return map[string]interface{}{
+		"name":   d.Get("name").(string),
+		"code":   d.Get("code").(string),
+		"labels": labelsPayload,
+		"taskType": map[string]interface{}{
+			"code": "httpTask",
+		},
+		"taskOptions":       taskOptions,
+		"resultType":        d.Get("result_type"),
+		"executeTarget":     "local",
+		"visibility":        d.Get("visibility"),
+		"retryable":         d.Get("retryable"),
+		"retryCount":        d.Get("retry_count"),
+		"retryDelaySeconds": d.Get("retry_delay_seconds"),
+		"allowCustomConfig": d.Get("allow_custom_config"),
+	}
+}
+
+type HttpTaskHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
@@ -3,7 +3,10 @@ package morpheus
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
+	"regexp"
+	"sort"
 	"strings"
 
 	"log"
@@ -21,6 +24,7 @@ func resourceInstanceCatalogItem() *schema.Resource {
 		ReadContext:   resourceInstanceCatalogItemRead,
 		UpdateContext: resourceInstanceCatalogItemUpdate,
 		DeleteContext: resourceInstanceCatalogItemDelete,
+		CustomizeDiff: catalogItemOptionTypesCustomizeDiff("content", "config"),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -365,3 +369,101 @@ func resourceInstanceCatalogItemDelete(ctx context.Context, d *schema.ResourceDa
 	d.SetId("")
 	return diags
 }
+
+var customOptionReferencePattern = regexp.MustCompile(`customOptions(?:\.([A-Za-z_$][\w$]*)|\[\s*['"]([^'"]+)['"]\s*\])`)
+
+// catalogItemOptionTypesCustomizeDiff reports the customOptions fields referenced by the
+// attributes of the catalog item that are not defined by its option types at plan time
+// instead of when the item is ordered
+func catalogItemOptionTypesCustomizeDiff(attributes ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.HasChanges(append(attributes, "option_type_ids", "form_id")...) {
+			return nil
+		}
+
+		// The fields of a form are not validated and values computed from other
+		// resources are not known until apply
+		if _, ok := d.GetOk("form_id"); ok || !d.NewValueKnown("form_id") || !d.NewValueKnown("option_type_ids") {
+			return nil
+		}
+		for _, attribute := range attributes {
+			if !d.NewValueKnown(attribute) {
+				return nil
+			}
+		}
+
+		// Collect the references of each attribute, the JSON attributes report the key referencing the field
+		references := make(map[string][]string)
+		for _, attribute := range attributes {
+			value := d.Get(attribute).(string)
+			var document interface{}
+			if err := json.Unmarshal([]byte(value), &document); err == nil {
+				if _, ok := document.(map[string]interface{}); ok {
+					collectCustomOptionReferences(references, attribute, document)
+					continue
+				}
+			}
+			collectCustomOptionReferences(references, attribute, value)
+		}
+		if len(references) == 0 {
+			return nil
+		}
+
+		client := meta.(*morpheus.Client)
+		fieldNames := make(map[string]bool)
+		for _, optionTypeId := range d.Get("option_type_ids").([]interface{}) {
+			resp, err := client.GetOptionType(int64(optionTypeId.(int)), &morpheus.Request{})
+			if err != nil {
+				if resp != nil && resp.StatusCode == 404 {
+					return fmt.Errorf("option_type_ids: option type %d does not exist", optionTypeId.(int))
+				}
+				log.Printf("API FAILURE: %s - %s", resp, err)
+				return err
+			}
+			log.Printf("API RESPONSE: %s", resp)
+			optionType := resp.Result.(*morpheus.GetOptionTypeResult).OptionType
+			if optionType != nil {
+				fieldNames[optionType.FieldName] = true
+			}
+		}
+
+		var problems []string
+		for location, fields := range references {
+			reported := make(map[string]bool)
+			for _, field := range fields {
+				if !fieldNames[field] && !reported[field] {
+					reported[field] = true
+					problems = append(problems, fmt.Sprintf("%s: customOptions.%s is not defined by the option types of the catalog item", location, field))
+				}
+			}
+		}
+		if len(problems) > 0 {
+			sort.Strings(problems)
+			return fmt.Errorf("invalid catalog item option type references:\n  %s", strings.Join(problems, "\n  "))
+		}
+		return nil
+	}
+}
+
+// collectCustomOptionReferences adds the customOptions fields referenced by the value to the
+// references keyed by their location, nested values are located by their key path
+func collectCustomOptionReferences(references map[string][]string, location string, value interface{}) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, item := range value {
+			collectCustomOptionReferences(references, location+"."+key, item)
+		}
+	case []interface{}:
+		for i, item := range value {
+			collectCustomOptionReferences(references, fmt.Sprintf("%s[%d]", location, i), item)
+		}
+	case string:
+		for _, match := range customOptionReferencePattern.FindAllStringSubmatch(value, -1) {
+			field := match[1]
+			if field == "" {
+				field = match[2]
+			}
+			references[location] = append(references[location], field)
+		}
+	}
+}
@@ -0,0 +1,475 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var scheduleTimeFormat, _ = regexp.Compile("^([01][0-9]|2[0-3]):[0-5][0-9]$")
+
+func resourceInstanceScale() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus instance scale resource which binds a scale threshold to an existing instance",
+		CreateContext: resourceInstanceScaleCreate,
+		ReadContext:   resourceInstanceScaleRead,
+		UpdateContext: resourceInstanceScaleUpdate,
+		DeleteContext: resourceInstanceScaleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the instance the scaling configuration is applied to",
+				Computed:    true,
+			},
+			"instance_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the instance to configure horizontal scaling for",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"scale_threshold_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the scale threshold applied to the instance outside of any scaling schedule",
+				Required:    true,
+			},
+			"min_count": {
+				Type:         schema.TypeInt,
+				Description:  "The minimum number of nodes to scale down to, overrides the value from the scale threshold",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_count": {
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of nodes to scale up to, overrides the value from the scale threshold",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"load_balancer_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the load balancer that new nodes are added to when the instance scales",
+				Optional:    true,
+			},
+			"schedule": {
+				Type:        schema.TypeList,
+				Description: "The scaling windows during which a different scale threshold is applied to the instance",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The id of the scaling schedule",
+							Computed:    true,
+						},
+						"scale_threshold_id": {
+							Type:        schema.TypeInt,
+							Description: "The id of the scale threshold applied during the scaling window",
+							Required:    true,
+						},
+						"start_day_of_week": {
+							Type:         schema.TypeInt,
+							Description:  "The day of the week the scaling window starts (1 = Sunday, 7 = Saturday)",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 7),
+						},
+						"start_time": {
+							Type:         schema.TypeString,
+							Description:  "The time of day the scaling window starts (HH:MM)",
+							Required:     true,
+							ValidateFunc: validation.StringMatch(scheduleTimeFormat, "start_time must be in the HH:MM format"),
+						},
+						"end_day_of_week": {
+							Type:         schema.TypeInt,
+							Description:  "The day of the week the scaling window ends (1 = Sunday, 7 = Saturday)",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 7),
+						},
+						"end_time": {
+							Type:         schema.TypeString,
+							Description:  "The time of day the scaling window ends (HH:MM)",
+							Required:     true,
+							ValidateFunc: validation.StringMatch(scheduleTimeFormat, "end_time must be in the HH:MM format"),
+						},
+					},
+				},
+			},
+			"current_node_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of nodes the instance is currently running",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceInstanceScaleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	instanceId := int64(d.Get("instance_id").(int))
+
+	if err := updateInstanceScaleThreshold(client, instanceId, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Successfully bound the threshold, now set id
+	d.SetId(int64ToString(instanceId))
+
+	if err := syncInstanceScaleSchedules(client, instanceId, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceInstanceScaleRead(ctx, d, meta)
+	return diags
+}
+
+func resourceInstanceScaleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.GetInstance(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.GetInstanceResult)
+	instance := result.Instance
+	d.Set("instance_id", instance.ID)
+	d.Set("current_node_count", len(instance.Containers))
+
+	// Instance threshold
+	resp, err = client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/%d/threshold", morpheus.InstancesPath, instance.ID),
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var instanceThreshold instanceThresholdPayload
+	if err := json.Unmarshal(resp.Body, &instanceThreshold); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The settings of the scale threshold are copied onto the instance without a reference to the
+	// threshold, so the id stored in the state is kept and the threshold is only matched on import
+	if d.Get("scale_threshold_id").(int) == 0 {
+		scaleThresholdId, err := matchInstanceScaleThreshold(client, instanceThreshold)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("scale_threshold_id", scaleThresholdId)
+	}
+
+	// The counts are only refreshed when they override the values of the scale threshold
+	if d.Get("min_count").(int) != 0 {
+		d.Set("min_count", instanceThreshold.InstanceThreshold.MinCount)
+	}
+	if d.Get("max_count").(int) != 0 {
+		d.Set("max_count", instanceThreshold.InstanceThreshold.MaxCount)
+	}
+	d.Set("load_balancer_id", instanceThreshold.InstanceThreshold.LoadBalancer.ID)
+
+	// Scaling schedules
+	resp, err = client.ListInstanceSchedules(instance.ID, &morpheus.Request{})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	scheduleResult := resp.Result.(*morpheus.ListInstanceScheduleResult)
+	var schedules []map[string]interface{}
+	for _, instanceSchedule := range scheduleResult.InstanceSchedules {
+		schedule := make(map[string]interface{})
+		schedule["id"] = instanceSchedule.ID
+		schedule["scale_threshold_id"] = instanceSchedule.Threshold.ID
+		schedule["start_day_of_week"] = instanceSchedule.StartDayOfWeek
+		schedule["start_time"] = instanceSchedule.StartTime
+		schedule["end_day_of_week"] = instanceSchedule.EndDayOfWeek
+		schedule["end_time"] = instanceSchedule.EndTime
+		schedules = append(schedules, schedule)
+	}
+	d.Set("schedule", schedules)
+
+	return diags
+}
+
+func resourceInstanceScaleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	instanceId := toInt64(d.Id())
+
+	if d.HasChanges("scale_threshold_id", "min_count", "max_count", "load_balancer_id") {
+		if err := updateInstanceScaleThreshold(client, instanceId, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("schedule") {
+		if err := syncInstanceScaleSchedules(client, instanceId, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceInstanceScaleRead(ctx, d, meta)
+}
+
+func resourceInstanceScaleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	instanceId := toInt64(d.Id())
+
+	// Remove the scaling windows
+	resp, err := client.ListInstanceSchedules(instanceId, &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		}
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	scheduleResult := resp.Result.(*morpheus.ListInstanceScheduleResult)
+	for _, instanceSchedule := range scheduleResult.InstanceSchedules {
+		resp, err := client.DeleteInstanceSchedule(instanceId, instanceSchedule.ID, &morpheus.Request{})
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+
+	// Disable automatic scaling on the instance
+	resp, err = client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("%s/%d/threshold", morpheus.InstancesPath, instanceId),
+		Body: map[string]interface{}{
+			"instanceThreshold": map[string]interface{}{
+				"autoUp":   false,
+				"autoDown": false,
+			},
+		},
+		Result: &morpheus.StandardResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		}
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// updateInstanceScaleThreshold copies the referenced scale threshold onto the
+// instance, applying the min/max count and load balancer overrides
+func updateInstanceScaleThreshold(client *morpheus.Client, instanceId int64, d *schema.ResourceData) error {
+	resp, err := client.GetScaleThreshold(int64(d.Get("scale_threshold_id").(int)), &morpheus.Request{})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	scaleThreshold := resp.Result.(*morpheus.GetScaleThresholdResult).ScaleThreshold
+
+	instanceThreshold := map[string]interface{}{
+		"autoUp":         scaleThreshold.AutoUp,
+		"autoDown":       scaleThreshold.AutoDown,
+		"scaleIncrement": scaleThreshold.ScaleIncrement,
+		"cpuEnabled":     scaleThreshold.CpuEnabled,
+		"minCpu":         scaleThreshold.MinCpu,
+		"maxCpu":         scaleThreshold.MaxCpu,
+		"memoryEnabled":  scaleThreshold.MemoryEnabled,
+		"minMemory":      scaleThreshold.MinMemory,
+		"maxMemory":      scaleThreshold.MaxMemory,
+		"diskEnabled":    scaleThreshold.DiskEnabled,
+		"minDisk":        scaleThreshold.MinDisk,
+		"maxDisk":        scaleThreshold.MaxDisk,
+	}
+
+	// Only apply the overrides that are set in the configuration, otherwise the threshold values are used
+	minCount, maxCount := scaleThreshold.MinCount, scaleThreshold.MaxCount
+	if !d.GetRawConfig().GetAttr("min_count").IsNull() {
+		minCount = int64(d.Get("min_count").(int))
+	}
+	if !d.GetRawConfig().GetAttr("max_count").IsNull() {
+		maxCount = int64(d.Get("max_count").(int))
+	}
+	instanceThreshold["minCount"] = minCount
+	instanceThreshold["maxCount"] = maxCount
+	if minCount > maxCount {
+		return fmt.Errorf("min_count (%d) cannot be greater than max_count (%d)", minCount, maxCount)
+	}
+
+	// The load balancer is cleared when it is not set
+	instanceThreshold["loadBalancer"] = nil
+	if d.Get("load_balancer_id").(int) > 0 {
+		instanceThreshold["loadBalancer"] = map[string]interface{}{
+			"id": d.Get("load_balancer_id").(int),
+		}
+	}
+
+	resp, err = client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("%s/%d/threshold", morpheus.InstancesPath, instanceId),
+		Body: map[string]interface{}{
+			"instanceThreshold": instanceThreshold,
+		},
+		Result: &morpheus.StandardResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return nil
+}
+
+// syncInstanceScaleSchedules replaces the scaling windows on the instance with
+// the schedule blocks in the configuration
+func syncInstanceScaleSchedules(client *morpheus.Client, instanceId int64, d *schema.ResourceData) error {
+	resp, err := client.ListInstanceSchedules(instanceId, &morpheus.Request{})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	existing := resp.Result.(*morpheus.ListInstanceScheduleResult).InstanceSchedules
+
+	schedules := d.Get("schedule").([]interface{})
+	for i, item := range schedules {
+		schedule := item.(map[string]interface{})
+		payload := map[string]interface{}{
+			"startDayOfWeek": schedule["start_day_of_week"].(int),
+			"startTime":      schedule["start_time"].(string),
+			"endDayOfWeek":   schedule["end_day_of_week"].(int),
+			"endTime":        schedule["end_time"].(string),
+			"threshold": map[string]interface{}{
+				"id": schedule["scale_threshold_id"].(int),
+			},
+		}
+		req := &morpheus.Request{
+			Body: map[string]interface{}{
+				"instanceSchedule": payload,
+			},
+		}
+
+		// Reuse the existing schedules in order so the schedule ids remain stable
+		if i < len(existing) {
+			resp, err = client.UpdateInstanceSchedule(instanceId, existing[i].ID, req)
+		} else {
+			resp, err = client.CreateInstanceSchedule(instanceId, req)
+		}
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+		log.Printf("API RESPONSE: %s", resp)
+	}
+
+	// Remove any schedules that are no longer configured
+	for i := len(schedules); i < len(existing); i++ {
+		resp, err = client.DeleteInstanceSchedule(instanceId, existing[i].ID, &morpheus.Request{})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// matchInstanceScaleThreshold returns the id of the first scale threshold with the settings of the
+// instance threshold, 0 is returned when the instance threshold does not match any scale threshold
+func matchInstanceScaleThreshold(client *morpheus.Client, instanceThreshold instanceThresholdPayload) (int64, error) {
+	resp, err := client.ListScaleThresholds(&morpheus.Request{
+		QueryParams: map[string]string{
+			"max": "250",
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return 0, err
+	}
+	result := resp.Result.(*morpheus.ListScaleThresholdsResult)
+	if result.ScaleThresholds != nil {
+		for _, scaleThreshold := range *result.ScaleThresholds {
+			if instanceThreshold.matches(scaleThreshold) {
+				return scaleThreshold.ID, nil
+			}
+		}
+	}
+	return 0, nil
+}
+
+type instanceThresholdPayload struct {
+	InstanceThreshold struct {
+		ID             int64   `json:"id"`
+		AutoUp         bool    `json:"autoUp"`
+		AutoDown       bool    `json:"autoDown"`
+		MinCount       int64   `json:"minCount"`
+		MaxCount       int64   `json:"maxCount"`
+		ScaleIncrement int64   `json:"scaleIncrement"`
+		CpuEnabled     bool    `json:"cpuEnabled"`
+		MinCpu         float64 `json:"minCpu"`
+		MaxCpu         float64 `json:"maxCpu"`
+		MemoryEnabled  bool    `json:"memoryEnabled"`
+		MinMemory      float64 `json:"minMemory"`
+		MaxMemory      float64 `json:"maxMemory"`
+		DiskEnabled    bool    `json:"diskEnabled"`
+		MinDisk        float64 `json:"minDisk"`
+		MaxDisk        float64 `json:"maxDisk"`
+		LoadBalancer   struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"loadBalancer"`
+	} `json:"instanceThreshold"`
+}
+
+// matches reports whether the instance threshold has the settings of the scale threshold,
+// the min and max count are not compared as they can be overridden
+func (p instanceThresholdPayload) matches(scaleThreshold morpheus.ScaleThreshold) bool {
+	t := p.InstanceThreshold
+	return t.AutoUp == scaleThreshold.AutoUp &&
+		t.AutoDown == scaleThreshold.AutoDown &&
+		t.ScaleIncrement == scaleThreshold.ScaleIncrement &&
+		t.CpuEnabled == scaleThreshold.CpuEnabled &&
+		t.MinCpu == scaleThreshold.MinCpu &&
+		t.MaxCpu == scaleThreshold.MaxCpu &&
+		t.MemoryEnabled == scaleThreshold.MemoryEnabled &&
+		t.MinMemory == scaleThreshold.MinMemory &&
+		t.MaxMemory == scaleThreshold.MaxMemory &&
+		t.DiskEnabled == scaleThreshold.DiskEnabled &&
+		t.MinDisk == scaleThreshold.MinDisk &&
+		t.MaxDisk == scaleThreshold.MaxDisk
+}
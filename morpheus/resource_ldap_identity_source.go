@@ -0,0 +1,379 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceLDAPIdentitySource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides an LDAP identity source resource",
+		CreateContext: resourceLDAPIdentitySourceCreate,
+		ReadContext:   resourceLDAPIdentitySourceRead,
+		UpdateContext: resourceLDAPIdentitySourceUpdate,
+		DeleteContext: resourceLDAPIdentitySourceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the LDAP identity source",
+				Computed:    true,
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the Morpheus tenant to associate the identity source with",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the LDAP identity source",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the LDAP identity source",
+				Optional:    true,
+				Computed:    true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The URL of the LDAP server (i.e. - ldaps://ldap.example.com:636)",
+				Required:    true,
+			},
+			"binding_username": {
+				Type:        schema.TypeString,
+				Description: "The distinguished name of the account used to bind to the LDAP server",
+				Required:    true,
+			},
+			"binding_password": {
+				Type:        schema.TypeString,
+				Description: "The password of the account used to bind to the LDAP server",
+				Required:    true,
+				Sensitive:   true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+			},
+			"required_group": {
+				Type:        schema.TypeString,
+				Description: "The LDAP group users must be in to access Morpheus",
+				Optional:    true,
+				Computed:    true,
+			},
+			"user_fqn_expression": {
+				Type:        schema.TypeString,
+				Description: "The expression used to build the distinguished name of a user from the username (i.e. - uid=$username,ou=users,dc=example,dc=com)",
+				Optional:    true,
+				Computed:    true,
+			},
+			"username_attribute": {
+				Type:        schema.TypeString,
+				Description: "The LDAP attribute mapped to the Morpheus username",
+				Optional:    true,
+				Computed:    true,
+			},
+			"common_name_attribute": {
+				Type:        schema.TypeString,
+				Description: "The LDAP attribute mapped to the common name of the user",
+				Optional:    true,
+				Computed:    true,
+			},
+			"first_name_attribute": {
+				Type:        schema.TypeString,
+				Description: "The LDAP attribute mapped to the Morpheus user First Name",
+				Optional:    true,
+				Computed:    true,
+			},
+			"last_name_attribute": {
+				Type:        schema.TypeString,
+				Description: "The LDAP attribute mapped to the Morpheus user Last Name",
+				Optional:    true,
+				Computed:    true,
+			},
+			"email_attribute": {
+				Type:        schema.TypeString,
+				Description: "The LDAP attribute mapped to the Morpheus user email address",
+				Optional:    true,
+				Computed:    true,
+			},
+			"unique_member_attribute": {
+				Type:        schema.TypeString,
+				Description: "The LDAP attribute of a group listing its members",
+				Optional:    true,
+				Computed:    true,
+			},
+			"member_of_attribute": {
+				Type:        schema.TypeString,
+				Description: "The LDAP attribute of a user listing the groups it is a member of",
+				Optional:    true,
+				Computed:    true,
+			},
+			"default_account_role_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the default role a user is assigned when they are in the required group or if no specific group mapping applies to the user",
+				Required:    true,
+			},
+			"enable_role_mapping_permission": {
+				Type:        schema.TypeBool,
+				Description: "When enabled, Tenant users with appropriate rights to view and edit Roles will have the ability to set role mapping for the Identity Source integration",
+				Optional:    true,
+				Computed:    true,
+			},
+			"role_mapping": {
+				Description: "The LDAP to Morpheus Role mapping",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_id": {
+							Description: "The id of the Morpheus role to map to",
+							Type:        schema.TypeInt,
+							Optional:    true,
+						},
+						"role_name": {
+							Description: "The name or authority of the Morpheus role to map to",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"ldap_group_name": {
+							Description: "The name of the LDAP group to map to",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+						"ldap_group_fqn": {
+							Description: "The distinguished name of the LDAP group to map to (i.e. - cn=admins,ou=groups,dc=example,dc=com)",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceLDAPIdentitySourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	identitySource := ldapIdentitySourcePayload(d)
+	identitySource["config"].(map[string]interface{})["bindingPassword"] = d.Get("binding_password").(string)
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"userSource": identitySource,
+		},
+	}
+
+	resp, err := client.CreateIdentitySource(int64(d.Get("tenant_id").(int)), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateIdentitySourceResult)
+	identitySourceResult := result.IdentitySource
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(identitySourceResult.ID))
+
+	resourceLDAPIdentitySourceRead(ctx, d, meta)
+	return diags
+}
+
+func resourceLDAPIdentitySourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindIdentitySourceByName(name)
+	} else if id != "" {
+		resp, err = client.GetIdentitySource(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Identity source cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetIdentitySourceResult)
+	identitySource := result.IdentitySource
+	d.SetId(int64ToString(identitySource.ID))
+	d.Set("name", identitySource.Name)
+	d.Set("description", identitySource.Description)
+	d.Set("url", identitySource.Config.URL)
+	d.Set("binding_username", identitySource.Config.BindingUsername)
+	d.Set("binding_password", identitySource.Config.BindingPasswordHash)
+	d.Set("required_group", identitySource.Config.RequiredGroup)
+	d.Set("user_fqn_expression", identitySource.Config.UserFqnExpression)
+	d.Set("username_attribute", identitySource.Config.UsernameAttribute)
+	d.Set("common_name_attribute", identitySource.Config.CommonNameAttribute)
+	d.Set("first_name_attribute", identitySource.Config.FirstNameAttribute)
+	d.Set("last_name_attribute", identitySource.Config.LastNameAttribute)
+	d.Set("email_attribute", identitySource.Config.EmailAttribute)
+	d.Set("unique_member_attribute", identitySource.Config.UniqueMemberAttribute)
+	d.Set("member_of_attribute", identitySource.Config.MemberOfAttribute)
+	d.Set("default_account_role_id", identitySource.DefaultAccountRole.ID)
+	d.Set("enable_role_mapping_permission", identitySource.AllowCustomMappings)
+
+	var roleMappingPayload []map[string]interface{}
+
+	for _, roleMapping := range identitySource.RoleMappings {
+		roleOutput := make(map[string]interface{})
+		roleOutput["ldap_group_fqn"] = roleMapping.SourceRoleFqn
+		roleOutput["ldap_group_name"] = roleMapping.SourceRoleName
+		roleOutput["role_id"] = roleMapping.MappedRole.ID
+		roleOutput["role_name"] = roleMapping.MappedRole.Authority
+		roleMappingPayload = append(roleMappingPayload, roleOutput)
+	}
+	d.Set("role_mapping", roleMappingPayload)
+	return diags
+}
+
+func resourceLDAPIdentitySourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	identitySource := ldapIdentitySourcePayload(d)
+	if d.HasChange("binding_password") {
+		identitySource["config"].(map[string]interface{})["bindingPassword"] = d.Get("binding_password").(string)
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"userSource": identitySource,
+		},
+	}
+
+	resp, err := client.UpdateIdentitySource(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateIdentitySourceResult)
+	identitySourceResult := result.IdentitySource
+
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(identitySourceResult.ID))
+	return resourceLDAPIdentitySourceRead(ctx, d, meta)
+}
+
+func resourceLDAPIdentitySourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteIdentitySource(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func ldapIdentitySourcePayload(d *schema.ResourceData) map[string]interface{} {
+	identitySource := make(map[string]interface{})
+
+	identitySource["name"] = d.Get("name").(string)
+	identitySource["description"] = d.Get("description").(string)
+	identitySource["type"] = "ldap"
+
+	config := make(map[string]interface{})
+	config["url"] = d.Get("url").(string)
+	config["bindingUsername"] = d.Get("binding_username").(string)
+	config["requiredGroup"] = d.Get("required_group").(string)
+	config["userFqnExpression"] = d.Get("user_fqn_expression").(string)
+	config["usernameAttribute"] = d.Get("username_attribute").(string)
+	config["commonNameAttribute"] = d.Get("common_name_attribute").(string)
+	config["firstNameAttribute"] = d.Get("first_name_attribute").(string)
+	config["lastNameAttribute"] = d.Get("last_name_attribute").(string)
+	config["emailAttribute"] = d.Get("email_attribute").(string)
+	config["uniqueMemberAttribute"] = d.Get("unique_member_attribute").(string)
+	config["memberOfAttribute"] = d.Get("member_of_attribute").(string)
+	identitySource["config"] = config
+
+	defaultAccountRole := make(map[string]interface{})
+	defaultAccountRole["id"] = d.Get("default_account_role_id").(int)
+	identitySource["defaultAccountRole"] = defaultAccountRole
+
+	// Role Mappings
+	identitySource["roleMappings"] = parseIdentitySourceRoleMappings(d.Get("role_mapping").(*schema.Set), "ldap_group_name", "ldap_group_fqn")
+	identitySource["allowCustomMappings"] = d.Get("enable_role_mapping_permission").(bool)
+	return identitySource
+}
+
+// parseIdentitySourceRoleMappings builds the role mappings payload of an identity source,
+// the source group is identified by the name attribute and the optional fqn attribute
+func parseIdentitySourceRoleMappings(mappings *schema.Set, nameAttribute string, fqnAttribute string) []map[string]interface{} {
+	roleMappings := make([]map[string]interface{}, 0)
+	// iterate over the array of roleMappings
+	for _, mapping := range mappings.List() {
+		row := make(map[string]interface{})
+		mappedRole := make(map[string]interface{})
+		mappingConfig := mapping.(map[string]interface{})
+		for k, v := range mappingConfig {
+			switch k {
+			case "role_id":
+				mappedRole["id"] = v.(int)
+			case "role_name":
+				mappedRole["authority"] = v.(string)
+			case nameAttribute:
+				row["sourceRoleName"] = v.(string)
+			case fqnAttribute:
+				row["sourceRoleFqn"] = v.(string)
+			}
+		}
+		row["mappedRole"] = mappedRole
+		roleMappings = append(roleMappings, row)
+	}
+	return roleMappings
+}
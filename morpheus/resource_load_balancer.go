@@ -0,0 +1,314 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLoadBalancer() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus load balancer resource",
+		CreateContext: resourceLoadBalancerCreate,
+		ReadContext:   resourceLoadBalancerRead,
+		UpdateContext: resourceLoadBalancerUpdate,
+		DeleteContext: resourceLoadBalancerDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the load balancer",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the load balancer",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the load balancer",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type_code": {
+				Type:        schema.TypeString,
+				Description: "The code of the load balancer type (f5, nsx-t, avi, etc.)",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the cloud the load balancer is associated with",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Determines whether the load balancer is visible in sub-tenants or not",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Default:      "private",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the load balancer is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "The hostname or ip address of the load balancer management api",
+				Required:    true,
+			},
+			"api_port": {
+				Type:        schema.TypeInt,
+				Description: "The port of the load balancer management api",
+				Optional:    true,
+				Computed:    true,
+			},
+			"admin_port": {
+				Type:        schema.TypeInt,
+				Description: "The port of the load balancer administrative interface",
+				Optional:    true,
+				Computed:    true,
+			},
+			"username": {
+				Type:          schema.TypeString,
+				Description:   "The username of the account used to connect to the load balancer",
+				Optional:      true,
+				ConflictsWith: []string{"credential_id"},
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "The password of the account used to connect to the load balancer",
+				Optional:    true,
+				Sensitive:   true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+				ConflictsWith: []string{"credential_id"},
+			},
+			"credential_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the credential store entry used for authentication",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"username", "password"},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	loadBalancer := loadBalancerPayload(d)
+	loadBalancer["type"] = map[string]interface{}{
+		"code": d.Get("type_code").(string),
+	}
+	if d.Get("cloud_id").(int) != 0 {
+		loadBalancer["cloud"] = map[string]interface{}{
+			"id": d.Get("cloud_id").(int),
+		}
+	}
+	if d.Get("credential_id").(int) == 0 {
+		loadBalancer["password"] = d.Get("password").(string)
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"loadBalancer": loadBalancer,
+		},
+	}
+
+	resp, err := client.CreateLoadBalancer(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateLoadBalancerResult)
+	loadBalancerResult := result.LoadBalancer
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(loadBalancerResult.ID))
+
+	resourceLoadBalancerRead(ctx, d, meta)
+	return diags
+}
+
+func resourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindLoadBalancerByName(name)
+	} else if id != "" {
+		resp, err = client.GetLoadBalancer(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Load balancer cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetLoadBalancerResult)
+	loadBalancer := result.LoadBalancer
+	if loadBalancer == nil {
+		return diag.Errorf("read operation: load balancer not found in response data") // should not happen
+	}
+
+	var payload LoadBalancerCredentialPayload
+	json.Unmarshal(resp.Body, &payload)
+
+	d.SetId(int64ToString(loadBalancer.ID))
+	d.Set("name", loadBalancer.Name)
+	d.Set("description", loadBalancer.Description)
+	d.Set("type_code", loadBalancer.Type.Code)
+	d.Set("cloud_id", loadBalancer.Cloud.ID)
+	d.Set("visibility", loadBalancer.Visibility)
+	d.Set("enabled", loadBalancer.Enabled)
+	d.Set("host", loadBalancer.Host)
+	d.Set("api_port", loadBalancer.ApiPort)
+	d.Set("admin_port", loadBalancer.AdminPort)
+	if payload.LoadBalancer.Credential.ID == 0 {
+		d.Set("username", loadBalancer.Username)
+		d.Set("password", loadBalancer.PasswordHash)
+		d.Set("credential_id", 0)
+	} else {
+		d.Set("credential_id", payload.LoadBalancer.Credential.ID)
+	}
+
+	return diags
+}
+
+func resourceLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	loadBalancer := loadBalancerPayload(d)
+	if d.Get("credential_id").(int) == 0 && d.HasChange("password") {
+		loadBalancer["password"] = d.Get("password").(string)
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"loadBalancer": loadBalancer,
+		},
+	}
+
+	resp, err := client.UpdateLoadBalancer(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateLoadBalancerResult)
+	loadBalancerResult := result.LoadBalancer
+
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(loadBalancerResult.ID))
+	return resourceLoadBalancerRead(ctx, d, meta)
+}
+
+func resourceLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteLoadBalancer(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// loadBalancerPayload builds the attributes shared by the create and update requests
+func loadBalancerPayload(d *schema.ResourceData) map[string]interface{} {
+	loadBalancer := make(map[string]interface{})
+	loadBalancer["name"] = d.Get("name").(string)
+	loadBalancer["description"] = d.Get("description").(string)
+	loadBalancer["visibility"] = d.Get("visibility").(string)
+	loadBalancer["enabled"] = d.Get("enabled").(bool)
+	loadBalancer["host"] = d.Get("host").(string)
+	if d.Get("api_port").(int) != 0 {
+		loadBalancer["apiPort"] = d.Get("api_port").(int)
+	}
+	if d.Get("admin_port").(int) != 0 {
+		loadBalancer["adminPort"] = d.Get("admin_port").(int)
+	}
+
+	if d.Get("credential_id").(int) != 0 {
+		loadBalancer["credential"] = map[string]interface{}{
+			"type": "username-password",
+			"id":   d.Get("credential_id").(int),
+		}
+	} else {
+		loadBalancer["credential"] = map[string]interface{}{
+			"type": "local",
+		}
+		loadBalancer["username"] = d.Get("username").(string)
+	}
+	return loadBalancer
+}
+
+type LoadBalancerCredentialPayload struct {
+	LoadBalancer struct {
+		Credential struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+			Type string `json:"type"`
+		} `json:"credential"`
+	} `json:"loadBalancer"`
+}
@@ -0,0 +1,261 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLoadBalancerMonitor() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus load balancer monitor resource",
+		CreateContext: resourceLoadBalancerMonitorCreate,
+		ReadContext:   resourceLoadBalancerMonitorRead,
+		UpdateContext: resourceLoadBalancerMonitorUpdate,
+		DeleteContext: resourceLoadBalancerMonitorDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the load balancer monitor",
+				Computed:    true,
+			},
+			"load_balancer_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the load balancer the monitor is created on",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the load balancer monitor",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the load balancer monitor",
+				Optional:    true,
+				Computed:    true,
+			},
+			"monitor_type": {
+				Type:         schema.TypeString,
+				Description:  "The type of health check performed by the monitor (http, https, tcp, udp, icmp)",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"http", "https", "tcp", "udp", "icmp"}, false),
+			},
+			"interval": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds between health checks",
+				Optional:    true,
+				Computed:    true,
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds to wait for a response before a health check fails",
+				Optional:    true,
+				Computed:    true,
+			},
+			"send_data": {
+				Type:        schema.TypeString,
+				Description: "The request sent to the pool member, i.e. GET /health HTTP/1.1",
+				Optional:    true,
+				Computed:    true,
+			},
+			"receive_data": {
+				Type:        schema.TypeString,
+				Description: "The content expected in the response for the health check to succeed",
+				Optional:    true,
+				Computed:    true,
+			},
+			"receive_code": {
+				Type:        schema.TypeString,
+				Description: "The status codes expected in the response for the health check to succeed",
+				Optional:    true,
+				Computed:    true,
+			},
+			"destination": {
+				Type:        schema.TypeString,
+				Description: "The destination address and port of the health check, i.e. *:8080",
+				Optional:    true,
+				Computed:    true,
+			},
+			"fall_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of failed health checks before a pool member is marked down",
+				Optional:    true,
+				Computed:    true,
+			},
+			"rise_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of successful health checks before a pool member is marked up",
+				Optional:    true,
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the load balancer monitor",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importNestedResourceState("load_balancer_id"),
+		},
+	}
+}
+
+func resourceLoadBalancerMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	loadBalancerId := int64(d.Get("load_balancer_id").(int))
+
+	monitor := loadBalancerMonitorPayload(d)
+	monitor["monitorType"] = d.Get("monitor_type").(string)
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("%s/%d/monitors", morpheus.LoadBalancerMonitorsPath, loadBalancerId),
+		Body: map[string]interface{}{
+			"loadBalancerMonitor": monitor,
+		},
+		Result: &morpheus.CreateLoadBalancerMonitorResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateLoadBalancerMonitorResult)
+	monitorResult := result.LoadBalancerMonitor
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(monitorResult.ID))
+
+	resourceLoadBalancerMonitorRead(ctx, d, meta)
+	return diags
+}
+
+func resourceLoadBalancerMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	loadBalancerId := int64(d.Get("load_balancer_id").(int))
+
+	resp, err := client.GetLoadBalancerMonitor(loadBalancerId, toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetLoadBalancerMonitorResult)
+	monitor := result.LoadBalancerMonitor
+	if monitor == nil {
+		return diag.Errorf("read operation: load balancer monitor not found in response data") // should not happen
+	}
+
+	d.SetId(int64ToString(monitor.ID))
+	d.Set("load_balancer_id", monitor.LoadBalancer.ID)
+	d.Set("name", monitor.Name)
+	d.Set("description", monitor.Description)
+	d.Set("monitor_type", monitor.MonitorType)
+	d.Set("interval", monitor.MonitorInterval)
+	d.Set("timeout", monitor.MonitorTimeout)
+	d.Set("send_data", monitor.SendData)
+	d.Set("receive_data", monitor.ReceiveData)
+	d.Set("receive_code", monitor.ReceiveCode)
+	d.Set("destination", monitor.MonitorDestination)
+	d.Set("fall_count", monitor.FallCount)
+	d.Set("rise_count", monitor.RiseCount)
+	d.Set("status", monitor.Status)
+
+	return diags
+}
+
+func resourceLoadBalancerMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+	loadBalancerId := int64(d.Get("load_balancer_id").(int))
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"loadBalancerMonitor": loadBalancerMonitorPayload(d),
+		},
+	}
+
+	resp, err := client.UpdateLoadBalancerMonitor(loadBalancerId, toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceLoadBalancerMonitorRead(ctx, d, meta)
+}
+
+func resourceLoadBalancerMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	loadBalancerId := int64(d.Get("load_balancer_id").(int))
+	resp, err := client.DeleteLoadBalancerMonitor(loadBalancerId, toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func loadBalancerMonitorPayload(d *schema.ResourceData) map[string]interface{} {
+	monitor := make(map[string]interface{})
+	monitor["name"] = d.Get("name").(string)
+	monitor["description"] = d.Get("description").(string)
+
+	// Only send the optional settings that are configured so the appliance defaults are kept
+	optionalSettings := map[string]string{
+		"interval":     "monitorInterval",
+		"timeout":      "monitorTimeout",
+		"send_data":    "sendData",
+		"receive_data": "receiveData",
+		"receive_code": "receiveCode",
+		"destination":  "monitorDestination",
+		"fall_count":   "fallCount",
+		"rise_count":   "riseCount",
+	}
+	for attribute, field := range optionalSettings {
+		if value, ok := d.GetOk(attribute); ok {
+			monitor[field] = value
+		}
+	}
+	return monitor
+}
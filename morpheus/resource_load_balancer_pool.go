@@ -0,0 +1,240 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLoadBalancerPool() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus load balancer pool resource",
+		CreateContext: resourceLoadBalancerPoolCreate,
+		ReadContext:   resourceLoadBalancerPoolRead,
+		UpdateContext: resourceLoadBalancerPoolUpdate,
+		DeleteContext: resourceLoadBalancerPoolDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the load balancer pool",
+				Computed:    true,
+			},
+			"load_balancer_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the load balancer the pool is created on",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the load balancer pool",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the load balancer pool",
+				Optional:    true,
+				Computed:    true,
+			},
+			"balance_mode": {
+				Type:         schema.TypeString,
+				Description:  "The algorithm used to distribute traffic across the pool members (roundrobin, leastconnections, fastestresponse, sourceip)",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"roundrobin", "leastconnections", "fastestresponse", "sourceip"}, false),
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Description:  "The port the pool members receive traffic on",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"minimum_active_members": {
+				Type:        schema.TypeInt,
+				Description: "The minimum number of active members required for the pool to be considered up",
+				Optional:    true,
+				Computed:    true,
+			},
+			"monitor_ids": {
+				Type:        schema.TypeSet,
+				Description: "The ids of the load balancer monitors used to check the health of the pool members",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the load balancer pool is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the load balancer pool",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importNestedResourceState("load_balancer_id"),
+		},
+	}
+}
+
+func resourceLoadBalancerPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	loadBalancerId := int64(d.Get("load_balancer_id").(int))
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"loadBalancerPool": loadBalancerPoolPayload(d),
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("%s/%d/pools", morpheus.LoadBalancerPoolsPath, loadBalancerId),
+		Body:   req.Body,
+		Result: &morpheus.CreateLoadBalancerPoolResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateLoadBalancerPoolResult)
+	pool := result.LoadBalancerPool
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(pool.ID))
+
+	resourceLoadBalancerPoolRead(ctx, d, meta)
+	return diags
+}
+
+func resourceLoadBalancerPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	loadBalancerId := int64(d.Get("load_balancer_id").(int))
+
+	resp, err := client.GetLoadBalancerPool(loadBalancerId, toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetLoadBalancerPoolResult)
+	pool := result.LoadBalancerPool
+	if pool == nil {
+		return diag.Errorf("read operation: load balancer pool not found in response data") // should not happen
+	}
+
+	d.SetId(int64ToString(pool.ID))
+	d.Set("load_balancer_id", pool.LoadBalancer.ID)
+	d.Set("name", pool.Name)
+	d.Set("description", pool.Description)
+	d.Set("balance_mode", pool.VipBalance)
+	d.Set("port", pool.Port)
+	d.Set("minimum_active_members", pool.MinActive)
+	d.Set("enabled", pool.Enabled)
+	d.Set("status", pool.Status)
+	var monitorIds []int64
+	for _, monitor := range pool.Monitors {
+		monitorIds = append(monitorIds, monitor.ID)
+	}
+	d.Set("monitor_ids", monitorIds)
+
+	return diags
+}
+
+func resourceLoadBalancerPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+	loadBalancerId := int64(d.Get("load_balancer_id").(int))
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"loadBalancerPool": loadBalancerPoolPayload(d),
+		},
+	}
+
+	resp, err := client.UpdateLoadBalancerPool(loadBalancerId, toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceLoadBalancerPoolRead(ctx, d, meta)
+}
+
+func resourceLoadBalancerPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	loadBalancerId := int64(d.Get("load_balancer_id").(int))
+	resp, err := client.DeleteLoadBalancerPool(loadBalancerId, toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func loadBalancerPoolPayload(d *schema.ResourceData) map[string]interface{} {
+	pool := make(map[string]interface{})
+	pool["name"] = d.Get("name").(string)
+	pool["description"] = d.Get("description").(string)
+	pool["enabled"] = d.Get("enabled").(bool)
+	if d.Get("balance_mode").(string) != "" {
+		pool["vipBalance"] = d.Get("balance_mode").(string)
+	}
+	if d.Get("port").(int) != 0 {
+		pool["port"] = d.Get("port").(int)
+	}
+	if d.Get("minimum_active_members").(int) != 0 {
+		pool["minActive"] = d.Get("minimum_active_members").(int)
+	}
+
+	var monitors []map[string]interface{}
+	for _, monitorId := range d.Get("monitor_ids").(*schema.Set).List() {
+		monitors = append(monitors, map[string]interface{}{
+			"id": monitorId,
+		})
+	}
+	pool["monitors"] = monitors
+	return pool
+}
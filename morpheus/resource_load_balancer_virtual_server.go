@@ -0,0 +1,281 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLoadBalancerVirtualServer() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus load balancer virtual server resource",
+		CreateContext: resourceLoadBalancerVirtualServerCreate,
+		ReadContext:   resourceLoadBalancerVirtualServerRead,
+		UpdateContext: resourceLoadBalancerVirtualServerUpdate,
+		DeleteContext: resourceLoadBalancerVirtualServerDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the load balancer virtual server",
+				Computed:    true,
+			},
+			"load_balancer_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the load balancer the virtual server is created on",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the load balancer virtual server",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the load balancer virtual server",
+				Optional:    true,
+				Computed:    true,
+			},
+			"vip_address": {
+				Type:         schema.TypeString,
+				Description:  "The virtual ip address the virtual server listens on",
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"vip_port": {
+				Type:         schema.TypeInt,
+				Description:  "The port the virtual server listens on",
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"vip_protocol": {
+				Type:         schema.TypeString,
+				Description:  "The protocol of the virtual server (tcp, udp, http, https)",
+				Optional:     true,
+				Default:      "tcp",
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "http", "https"}, false),
+			},
+			"vip_hostname": {
+				Type:        schema.TypeString,
+				Description: "The hostname of the virtual server",
+				Optional:    true,
+				Computed:    true,
+			},
+			"pool_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the load balancer pool that receives the traffic of the virtual server",
+				Optional:    true,
+			},
+			"instance_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the instance fronted by the virtual server",
+				Optional:    true,
+			},
+			"ssl_certificate_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the ssl certificate used by the virtual server",
+				Optional:    true,
+			},
+			"sticky": {
+				Type:        schema.TypeBool,
+				Description: "Whether session persistence is enabled for the virtual server",
+				Optional:    true,
+				Default:     false,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the load balancer virtual server",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importNestedResourceState("load_balancer_id"),
+		},
+	}
+}
+
+func resourceLoadBalancerVirtualServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	loadBalancerId := int64(d.Get("load_balancer_id").(int))
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("%s/%d/virtual-servers", morpheus.LoadBalancerVirtualServersPath, loadBalancerId),
+		Body: map[string]interface{}{
+			"loadBalancerInstance": loadBalancerVirtualServerPayload(d),
+		},
+		Result: &morpheus.CreateLoadBalancerVirtualServerResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateLoadBalancerVirtualServerResult)
+	virtualServer := result.LoadBalancerVirtualServer
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(virtualServer.ID))
+
+	resourceLoadBalancerVirtualServerRead(ctx, d, meta)
+	return diags
+}
+
+func resourceLoadBalancerVirtualServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	loadBalancerId := int64(d.Get("load_balancer_id").(int))
+
+	resp, err := client.GetLoadBalancerVirtualServer(loadBalancerId, toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// The sdk parses the virtual server into the wrong type so parse the body directly
+	var virtualServer LoadBalancerVirtualServerPayload
+	if err := json.Unmarshal(resp.Body, &virtualServer); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(int64ToString(virtualServer.LoadBalancerInstance.ID))
+	d.Set("load_balancer_id", virtualServer.LoadBalancerInstance.LoadBalancer.ID)
+	d.Set("name", virtualServer.LoadBalancerInstance.VipName)
+	d.Set("description", virtualServer.LoadBalancerInstance.Description)
+	d.Set("vip_address", virtualServer.LoadBalancerInstance.VipAddress)
+	d.Set("vip_port", virtualServer.LoadBalancerInstance.VipPort)
+	d.Set("vip_protocol", virtualServer.LoadBalancerInstance.VipProtocol)
+	d.Set("vip_hostname", virtualServer.LoadBalancerInstance.VipHostname)
+	d.Set("pool_id", virtualServer.LoadBalancerInstance.Pool.ID)
+	d.Set("instance_id", virtualServer.LoadBalancerInstance.Instance.ID)
+	d.Set("ssl_certificate_id", virtualServer.LoadBalancerInstance.SslCert.ID)
+	d.Set("sticky", virtualServer.LoadBalancerInstance.Sticky)
+	d.Set("status", virtualServer.LoadBalancerInstance.VipStatus)
+
+	return diags
+}
+
+func resourceLoadBalancerVirtualServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+	loadBalancerId := int64(d.Get("load_balancer_id").(int))
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"loadBalancerInstance": loadBalancerVirtualServerPayload(d),
+		},
+	}
+
+	resp, err := client.UpdateLoadBalancerVirtualServer(loadBalancerId, toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceLoadBalancerVirtualServerRead(ctx, d, meta)
+}
+
+func resourceLoadBalancerVirtualServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	loadBalancerId := int64(d.Get("load_balancer_id").(int))
+	resp, err := client.DeleteLoadBalancerVirtualServer(loadBalancerId, toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func loadBalancerVirtualServerPayload(d *schema.ResourceData) map[string]interface{} {
+	virtualServer := make(map[string]interface{})
+	virtualServer["vipName"] = d.Get("name").(string)
+	virtualServer["description"] = d.Get("description").(string)
+	virtualServer["vipAddress"] = d.Get("vip_address").(string)
+	virtualServer["vipPort"] = d.Get("vip_port").(int)
+	virtualServer["vipProtocol"] = d.Get("vip_protocol").(string)
+	virtualServer["vipHostname"] = d.Get("vip_hostname").(string)
+	virtualServer["sticky"] = d.Get("sticky").(bool)
+	if d.Get("pool_id").(int) != 0 {
+		virtualServer["defaultPool"] = map[string]interface{}{
+			"id": d.Get("pool_id").(int),
+		}
+	}
+	if d.Get("instance_id").(int) != 0 {
+		virtualServer["instance"] = map[string]interface{}{
+			"id": d.Get("instance_id").(int),
+		}
+	}
+	if d.Get("ssl_certificate_id").(int) != 0 {
+		virtualServer["sslCert"] = map[string]interface{}{
+			"id": d.Get("ssl_certificate_id").(int),
+		}
+	}
+	return virtualServer
+}
+
+type LoadBalancerVirtualServerPayload struct {
+	LoadBalancerInstance struct {
+		ID           int64 `json:"id"`
+		LoadBalancer struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"loadBalancer"`
+		Instance struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"instance"`
+		Pool struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"defaultPool"`
+		SslCert struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"sslCert"`
+		VipName     string `json:"vipName"`
+		Description string `json:"description"`
+		VipAddress  string `json:"vipAddress"`
+		VipPort     int64  `json:"vipPort"`
+		VipProtocol string `json:"vipProtocol"`
+		VipHostname string `json:"vipHostname"`
+		Sticky      bool   `json:"sticky"`
+		VipStatus   string `json:"vipStatus"`
+	} `json:"loadBalancerInstance"`
+}
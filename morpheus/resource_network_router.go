@@ -0,0 +1,331 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkRouter() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus network router resource",
+		CreateContext: resourceNetworkRouterCreate,
+		ReadContext:   resourceNetworkRouterRead,
+		UpdateContext: resourceNetworkRouterUpdate,
+		DeleteContext: resourceNetworkRouterDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the network router",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the network router",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the network router",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type_code": {
+				Type:        schema.TypeString,
+				Description: "The code of the network router type (nsx-t-tier1, amazon-vpc-router, openstack-router, etc.)",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"network_server_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the network server (i.e. NSX-T integration) the router is created on",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the cloud the router is created in",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the group the router is associated with",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"external_network_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the external network the router is attached to",
+				Optional:    true,
+				Computed:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the network router is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"enable_bgp": {
+				Type:        schema.TypeBool,
+				Description: "Whether bgp is enabled on the network router",
+				Optional:    true,
+				Default:     false,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Determines whether the network router is visible in sub-tenants or not",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Default:      "private",
+			},
+			"config": {
+				Type:             schema.TypeString,
+				Description:      "The router type specific settings (JSON), i.e. the tier-0 gateway or edge cluster of a NSX-T tier-1 router",
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the network router",
+				Computed:    true,
+			},
+			"external_ip": {
+				Type:        schema.TypeString,
+				Description: "The external ip address of the network router",
+				Computed:    true,
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the network router in the cloud or network server",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetworkRouterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	networkRouter := networkRouterPayload(d)
+	networkRouter["type"] = map[string]interface{}{
+		"code": d.Get("type_code").(string),
+	}
+	if d.Get("network_server_id").(int) != 0 {
+		networkRouter["networkServer"] = map[string]interface{}{
+			"id": d.Get("network_server_id").(int),
+		}
+	}
+	if d.Get("cloud_id").(int) != 0 {
+		networkRouter["zone"] = map[string]interface{}{
+			"id": d.Get("cloud_id").(int),
+		}
+	}
+	if d.Get("group_id").(int) != 0 {
+		networkRouter["site"] = map[string]interface{}{
+			"id": d.Get("group_id").(int),
+		}
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"networkRouter": networkRouter,
+		},
+	}
+
+	resp, err := client.CreateNetworkRouter(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateNetworkRouterResult)
+	networkRouterResult := result.NetworkRouter
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(networkRouterResult.ID))
+
+	resourceNetworkRouterRead(ctx, d, meta)
+	return diags
+}
+
+func resourceNetworkRouterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindNetworkRouterByName(name)
+	} else if id != "" {
+		resp, err = client.GetNetworkRouter(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Network router cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetNetworkRouterResult)
+	networkRouter := result.NetworkRouter
+	if networkRouter == nil {
+		return diag.Errorf("read operation: network router not found in response data") // should not happen
+	}
+
+	d.SetId(int64ToString(networkRouter.ID))
+	d.Set("name", networkRouter.Name)
+	d.Set("description", networkRouter.Description)
+	d.Set("type_code", networkRouter.Type.Code)
+	d.Set("network_server_id", networkRouter.NetworkServer.ID)
+	d.Set("cloud_id", networkRouter.Zone.ID)
+	d.Set("external_network_id", networkRouter.ExternalNetwork.ID)
+	d.Set("enabled", networkRouter.Enabled)
+	d.Set("enable_bgp", networkRouter.EnableBgp)
+	if networkRouter.Permissions.Visibility != "" {
+		d.Set("visibility", networkRouter.Permissions.Visibility)
+	}
+	d.Set("status", networkRouter.Status)
+	d.Set("external_ip", networkRouter.ExternalIp)
+	d.Set("external_id", networkRouter.ExternalId)
+
+	// The group and config are not part of the SDK network router
+	var payload networkRouterReadPayload
+	if err := json.Unmarshal(resp.Body, &payload); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("group_id", payload.NetworkRouter.Site.ID)
+
+	// Only the configured settings are refreshed, the router type adds settings of its own
+	configured := make(map[string]interface{})
+	if d.Get("config").(string) != "" {
+		json.Unmarshal([]byte(d.Get("config").(string)), &configured)
+	}
+	config := make(map[string]interface{})
+	for key, value := range payload.NetworkRouter.Config {
+		if _, ok := configured[key]; !ok && len(configured) > 0 {
+			continue
+		}
+		config[key] = value
+	}
+	configJson, err := json.Marshal(config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("config", string(configJson))
+
+	return diags
+}
+
+func resourceNetworkRouterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"networkRouter": networkRouterPayload(d),
+		},
+	}
+
+	resp, err := client.UpdateNetworkRouter(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateNetworkRouterResult)
+	networkRouterResult := result.NetworkRouter
+
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(networkRouterResult.ID))
+	return resourceNetworkRouterRead(ctx, d, meta)
+}
+
+func resourceNetworkRouterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteNetworkRouter(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// networkRouterReadPayload holds the attributes of the network router response the SDK does not parse
+type networkRouterReadPayload struct {
+	NetworkRouter struct {
+		Site struct {
+			ID int64 `json:"id"`
+		} `json:"site"`
+		Config map[string]interface{} `json:"config"`
+	} `json:"networkRouter"`
+}
+
+// networkRouterPayload builds the attributes shared by the create and update requests
+func networkRouterPayload(d *schema.ResourceData) map[string]interface{} {
+	networkRouter := make(map[string]interface{})
+	networkRouter["name"] = d.Get("name").(string)
+	networkRouter["description"] = d.Get("description").(string)
+	networkRouter["enabled"] = d.Get("enabled").(bool)
+	networkRouter["enableBgp"] = d.Get("enable_bgp").(bool)
+	networkRouter["visibility"] = d.Get("visibility").(string)
+	if d.Get("external_network_id").(int) != 0 {
+		networkRouter["externalNetwork"] = map[string]interface{}{
+			"id": d.Get("external_network_id").(int),
+		}
+	}
+	// The settings keep the value types of the JSON config
+	config := make(map[string]interface{})
+	if d.Get("config").(string) != "" {
+		json.Unmarshal([]byte(d.Get("config").(string)), &config)
+	}
+	networkRouter["config"] = config
+	return networkRouter
+}
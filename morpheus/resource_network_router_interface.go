@@ -0,0 +1,235 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkRouterInterface() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus network router interface resource",
+		CreateContext: resourceNetworkRouterInterfaceCreate,
+		ReadContext:   resourceNetworkRouterInterfaceRead,
+		UpdateContext: resourceNetworkRouterInterfaceUpdate,
+		DeleteContext: resourceNetworkRouterInterfaceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the network router interface",
+				Computed:    true,
+			},
+			"network_router_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the network router the interface is created on",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the network router interface",
+				Required:    true,
+			},
+			"interface_type": {
+				Type:         schema.TypeString,
+				Description:  "The type of the network router interface (internal, external, uplink)",
+				Optional:     true,
+				Default:      "internal",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"internal", "external", "uplink"}, false),
+			},
+			"network_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the network the interface is attached to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Description:  "The ip address of the interface on the attached network",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Description:  "The cidr of the interface on the attached network",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the network router interface is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importNestedResourceState("network_router_id"),
+		},
+	}
+}
+
+func resourceNetworkRouterInterfaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	networkRouterId := int64(d.Get("network_router_id").(int))
+
+	routerInterface := networkRouterInterfacePayload(d)
+	routerInterface["interfaceType"] = d.Get("interface_type").(string)
+	routerInterface["network"] = map[string]interface{}{
+		"id": d.Get("network_id").(int),
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("%s/%d/interfaces", morpheus.NetworkRoutersPath, networkRouterId),
+		Body: map[string]interface{}{
+			"networkRouterInterface": routerInterface,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var result NetworkRouterInterfacePayload
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return diag.FromErr(err)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.NetworkRouterInterface.ID))
+
+	resourceNetworkRouterInterfaceRead(ctx, d, meta)
+	return diags
+}
+
+func resourceNetworkRouterInterfaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := toInt64(d.Id())
+	networkRouterId := int64(d.Get("network_router_id").(int))
+
+	// The interfaces are returned as part of the network router
+	resp, err := client.GetNetworkRouter(networkRouterId, &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.GetNetworkRouterResult)
+	networkRouter := result.NetworkRouter
+	if networkRouter == nil {
+		return diag.Errorf("read operation: network router not found in response data") // should not happen
+	}
+
+	for _, routerInterface := range networkRouter.Interfaces {
+		if routerInterface.ID != id {
+			continue
+		}
+		d.SetId(int64ToString(routerInterface.ID))
+		d.Set("network_router_id", networkRouter.ID)
+		d.Set("name", routerInterface.Name)
+		d.Set("interface_type", routerInterface.InterfaceType)
+		d.Set("network_id", routerInterface.Network.ID)
+		d.Set("ip_address", routerInterface.IpAddress)
+		d.Set("cidr", routerInterface.Cidr)
+		d.Set("enabled", routerInterface.Enabled)
+		return diags
+	}
+
+	log.Printf("Network router interface %d not found on network router %d", id, networkRouterId)
+	log.Printf("Forcing recreation of resource")
+	d.SetId("")
+	return diags
+}
+
+func resourceNetworkRouterInterfaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+	networkRouterId := int64(d.Get("network_router_id").(int))
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("%s/%d/interfaces/%s", morpheus.NetworkRoutersPath, networkRouterId, id),
+		Body: map[string]interface{}{
+			"networkRouterInterface": networkRouterInterfacePayload(d),
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceNetworkRouterInterfaceRead(ctx, d, meta)
+}
+
+func resourceNetworkRouterInterfaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	networkRouterId := int64(d.Get("network_router_id").(int))
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("%s/%d/interfaces/%s", morpheus.NetworkRoutersPath, networkRouterId, id),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func networkRouterInterfacePayload(d *schema.ResourceData) map[string]interface{} {
+	routerInterface := make(map[string]interface{})
+	routerInterface["name"] = d.Get("name").(string)
+	routerInterface["enabled"] = d.Get("enabled").(bool)
+	if d.Get("ip_address").(string) != "" {
+		routerInterface["ipAddress"] = d.Get("ip_address").(string)
+	}
+	if d.Get("cidr").(string) != "" {
+		routerInterface["cidr"] = d.Get("cidr").(string)
+	}
+	return routerInterface
+}
+
+type NetworkRouterInterfacePayload struct {
+	NetworkRouterInterface struct {
+		ID int64 `json:"id"`
+	} `json:"networkRouterInterface"`
+}
@@ -0,0 +1,250 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkRouterNat() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus network router NAT rule resource",
+		CreateContext: resourceNetworkRouterNatCreate,
+		ReadContext:   resourceNetworkRouterNatRead,
+		UpdateContext: resourceNetworkRouterNatUpdate,
+		DeleteContext: resourceNetworkRouterNatDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the network router NAT rule",
+				Computed:    true,
+			},
+			"network_router_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the network router the NAT rule is created on",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the NAT rule",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the NAT rule",
+				Optional:    true,
+				Computed:    true,
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Description:  "The NAT action of the rule (SNAT, DNAT, REFLEXIVE, NO_SNAT, NO_DNAT)",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SNAT", "DNAT", "REFLEXIVE", "NO_SNAT", "NO_DNAT"}, false),
+			},
+			"source_network": {
+				Type:        schema.TypeString,
+				Description: "The source ip address or cidr matched by the rule",
+				Optional:    true,
+			},
+			"destination_network": {
+				Type:        schema.TypeString,
+				Description: "The destination ip address or cidr matched by the rule",
+				Optional:    true,
+			},
+			"translated_network": {
+				Type:        schema.TypeString,
+				Description: "The ip address or cidr the matched traffic is translated to",
+				Optional:    true,
+			},
+			"translated_ports": {
+				Type:        schema.TypeString,
+				Description: "The port or range of ports the matched traffic is translated to",
+				Optional:    true,
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Description: "The priority of the NAT rule, lower values are evaluated first",
+				Optional:    true,
+				Computed:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the NAT rule is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importNestedResourceState("network_router_id"),
+		},
+	}
+}
+
+func resourceNetworkRouterNatCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	networkRouterId := int64(d.Get("network_router_id").(int))
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("%s/%d/nats", morpheus.NetworkRoutersPath, networkRouterId),
+		Body: map[string]interface{}{
+			"networkRouterNAT": networkRouterNatPayload(d),
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var result NetworkRouterNatPayload
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return diag.FromErr(err)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.NetworkRouterNat.ID))
+
+	resourceNetworkRouterNatRead(ctx, d, meta)
+	return diags
+}
+
+func resourceNetworkRouterNatRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	networkRouterId := int64(d.Get("network_router_id").(int))
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/%d/nats/%s", morpheus.NetworkRoutersPath, networkRouterId, id),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var result NetworkRouterNatPayload
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return diag.FromErr(err)
+	}
+	nat := result.NetworkRouterNat
+
+	d.SetId(int64ToString(nat.ID))
+	d.Set("name", nat.Name)
+	d.Set("description", nat.Description)
+	d.Set("action", nat.Config.Action)
+	d.Set("source_network", nat.SourceNetwork)
+	d.Set("destination_network", nat.DestinationNetwork)
+	d.Set("translated_network", nat.TranslatedNetwork)
+	d.Set("translated_ports", nat.TranslatedPorts)
+	d.Set("priority", nat.Priority)
+	d.Set("enabled", nat.Enabled)
+
+	return diags
+}
+
+func resourceNetworkRouterNatUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+	networkRouterId := int64(d.Get("network_router_id").(int))
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("%s/%d/nats/%s", morpheus.NetworkRoutersPath, networkRouterId, id),
+		Body: map[string]interface{}{
+			"networkRouterNAT": networkRouterNatPayload(d),
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceNetworkRouterNatRead(ctx, d, meta)
+}
+
+func resourceNetworkRouterNatDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	networkRouterId := int64(d.Get("network_router_id").(int))
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("%s/%d/nats/%s", morpheus.NetworkRoutersPath, networkRouterId, id),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func networkRouterNatPayload(d *schema.ResourceData) map[string]interface{} {
+	nat := make(map[string]interface{})
+	nat["name"] = d.Get("name").(string)
+	nat["description"] = d.Get("description").(string)
+	nat["enabled"] = d.Get("enabled").(bool)
+	nat["sourceNetwork"] = d.Get("source_network").(string)
+	nat["destinationNetwork"] = d.Get("destination_network").(string)
+	nat["translatedNetwork"] = d.Get("translated_network").(string)
+	nat["translatedPorts"] = d.Get("translated_ports").(string)
+	if d.Get("priority").(int) != 0 {
+		nat["priority"] = d.Get("priority").(int)
+	}
+	nat["config"] = map[string]interface{}{
+		"action": d.Get("action").(string),
+	}
+	return nat
+}
+
+type NetworkRouterNatPayload struct {
+	NetworkRouterNat struct {
+		ID                 int64  `json:"id"`
+		Name               string `json:"name"`
+		Description        string `json:"description"`
+		Enabled            bool   `json:"enabled"`
+		Priority           int64  `json:"priority"`
+		SourceNetwork      string `json:"sourceNetwork"`
+		DestinationNetwork string `json:"destinationNetwork"`
+		TranslatedNetwork  string `json:"translatedNetwork"`
+		TranslatedPorts    string `json:"translatedPorts"`
+		Config             struct {
+			Action string `json:"action"`
+		} `json:"config"`
+	} `json:"networkRouterNAT"`
+}
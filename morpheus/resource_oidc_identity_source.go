@@ -0,0 +1,319 @@
+package morpheus
+
+import (
+	"context"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceOIDCIdentitySource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides an OpenID Connect (OIDC) identity source resource",
+		CreateContext: resourceOIDCIdentitySourceCreate,
+		ReadContext:   resourceOIDCIdentitySourceRead,
+		UpdateContext: resourceOIDCIdentitySourceUpdate,
+		DeleteContext: resourceOIDCIdentitySourceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the OIDC identity source",
+				Computed:    true,
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the Morpheus tenant to associate the identity source with",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the OIDC identity source",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the OIDC identity source",
+				Optional:    true,
+				Computed:    true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The issuer URL of the OpenID Connect provider",
+				Required:    true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Description: "The client id of the application registered with the OpenID Connect provider",
+				Required:    true,
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Description: "The client secret of the application registered with the OpenID Connect provider",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"logout_url": {
+				Type:        schema.TypeString,
+				Description: "The URL Morpheus will redirect to when an OIDC user logs out of Morpheus",
+				Optional:    true,
+				Computed:    true,
+			},
+			"username_attribute": {
+				Type:        schema.TypeString,
+				Description: "The claim to map to Morpheus username",
+				Optional:    true,
+				Computed:    true,
+			},
+			"given_name_attribute": {
+				Type:        schema.TypeString,
+				Description: "The claim to map to Morpheus user First Name",
+				Optional:    true,
+				Computed:    true,
+			},
+			"surname_attribute": {
+				Type:        schema.TypeString,
+				Description: "The claim to map to Morpheus user Last Name",
+				Optional:    true,
+				Computed:    true,
+			},
+			"email_attribute": {
+				Type:        schema.TypeString,
+				Description: "The claim to map to Morpheus user email address",
+				Optional:    true,
+				Computed:    true,
+			},
+			"role_attribute_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the claim that will map to Morpheus roles, such as groups",
+				Optional:    true,
+				Computed:    true,
+			},
+			"required_role_attribute_value": {
+				Type:        schema.TypeString,
+				Description: "The value of the role claim users must have to access Morpheus",
+				Optional:    true,
+				Computed:    true,
+			},
+			"default_account_role_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the default role a user is assigned when they are in the required group or if no specific group mapping applies to the user",
+				Required:    true,
+			},
+			"enable_role_mapping_permission": {
+				Type:        schema.TypeBool,
+				Description: "When enabled, Tenant users with appropriate rights to view and edit Roles will have the ability to set role mapping for the Identity Source integration",
+				Optional:    true,
+				Computed:    true,
+			},
+			"role_mapping": {
+				Description: "The OIDC to Morpheus Role mapping",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_id": {
+							Description: "The id of the Morpheus role to map to",
+							Type:        schema.TypeInt,
+							Optional:    true,
+						},
+						"role_name": {
+							Description: "The name or authority of the Morpheus role to map to",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"claim_value": {
+							Description: "The value of the role claim to map the role to",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceOIDCIdentitySourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	identitySource := oidcIdentitySourcePayload(d)
+	identitySource["config"].(map[string]interface{})["clientSecret"] = d.Get("client_secret").(string)
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"userSource": identitySource,
+		},
+	}
+
+	resp, err := client.CreateIdentitySource(int64(d.Get("tenant_id").(int)), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateIdentitySourceResult)
+	identitySourceResult := result.IdentitySource
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(identitySourceResult.ID))
+
+	resourceOIDCIdentitySourceRead(ctx, d, meta)
+	return diags
+}
+
+func resourceOIDCIdentitySourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindIdentitySourceByName(name)
+	} else if id != "" {
+		resp, err = client.GetIdentitySource(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Identity source cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data, the client secret is masked in the response so the configured value is kept
+	result := resp.Result.(*morpheus.GetIdentitySourceResult)
+	identitySource := result.IdentitySource
+	d.SetId(int64ToString(identitySource.ID))
+	d.Set("name", identitySource.Name)
+	d.Set("description", identitySource.Description)
+	d.Set("url", identitySource.Config.URL)
+	d.Set("client_id", identitySource.Config.ClientID)
+	d.Set("logout_url", identitySource.Config.LogoutURL)
+	d.Set("username_attribute", identitySource.Config.UsernameAttribute)
+	d.Set("given_name_attribute", identitySource.Config.GivenNameAttribute)
+	d.Set("surname_attribute", identitySource.Config.SurnameAttribute)
+	d.Set("email_attribute", identitySource.Config.EmailAttribute)
+	d.Set("role_attribute_name", identitySource.Config.RoleAttributeName)
+	d.Set("required_role_attribute_value", identitySource.Config.RequiredAttributeValue)
+	d.Set("default_account_role_id", identitySource.DefaultAccountRole.ID)
+	d.Set("enable_role_mapping_permission", identitySource.AllowCustomMappings)
+
+	var roleMappingPayload []map[string]interface{}
+
+	for _, roleMapping := range identitySource.RoleMappings {
+		roleOutput := make(map[string]interface{})
+		roleOutput["claim_value"] = roleMapping.SourceRoleName
+		roleOutput["role_id"] = roleMapping.MappedRole.ID
+		roleOutput["role_name"] = roleMapping.MappedRole.Authority
+		roleMappingPayload = append(roleMappingPayload, roleOutput)
+	}
+	d.Set("role_mapping", roleMappingPayload)
+	return diags
+}
+
+func resourceOIDCIdentitySourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	identitySource := oidcIdentitySourcePayload(d)
+	if d.HasChange("client_secret") {
+		identitySource["config"].(map[string]interface{})["clientSecret"] = d.Get("client_secret").(string)
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"userSource": identitySource,
+		},
+	}
+
+	resp, err := client.UpdateIdentitySource(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateIdentitySourceResult)
+	identitySourceResult := result.IdentitySource
+
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(identitySourceResult.ID))
+	return resourceOIDCIdentitySourceRead(ctx, d, meta)
+}
+
+func resourceOIDCIdentitySourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteIdentitySource(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func oidcIdentitySourcePayload(d *schema.ResourceData) map[string]interface{} {
+	identitySource := make(map[string]interface{})
+
+	identitySource["name"] = d.Get("name").(string)
+	identitySource["description"] = d.Get("description").(string)
+	identitySource["type"] = "oidc"
+
+	config := make(map[string]interface{})
+	config["url"] = d.Get("url").(string)
+	config["clientId"] = d.Get("client_id").(string)
+	config["logoutUrl"] = d.Get("logout_url").(string)
+	config["usernameAttribute"] = d.Get("username_attribute").(string)
+	config["givenNameAttribute"] = d.Get("given_name_attribute").(string)
+	config["surnameAttribute"] = d.Get("surname_attribute").(string)
+	config["emailAttribute"] = d.Get("email_attribute").(string)
+	config["roleAttributeName"] = d.Get("role_attribute_name").(string)
+	config["requiredAttributeValue"] = d.Get("required_role_attribute_value").(string)
+	identitySource["config"] = config
+
+	defaultAccountRole := make(map[string]interface{})
+	defaultAccountRole["id"] = d.Get("default_account_role_id").(int)
+	identitySource["defaultAccountRole"] = defaultAccountRole
+
+	// Role Mappings
+	identitySource["roleMappings"] = parseIdentitySourceRoleMappings(d.Get("role_mapping").(*schema.Set), "claim_value", "")
+	identitySource["allowCustomMappings"] = d.Get("enable_role_mapping_permission").(bool)
+	return identitySource
+}
@@ -0,0 +1,264 @@
+package morpheus
+
+import (
+	"context"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceOktaIdentitySource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides an Okta identity source resource",
+		CreateContext: resourceOktaIdentitySourceCreate,
+		ReadContext:   resourceOktaIdentitySourceRead,
+		UpdateContext: resourceOktaIdentitySourceUpdate,
+		DeleteContext: resourceOktaIdentitySourceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the Okta identity source",
+				Computed:    true,
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the Morpheus tenant to associate the identity source with",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the Okta identity source",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the Okta identity source",
+				Optional:    true,
+				Computed:    true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The URL of the Okta organization (i.e. - https://example.okta.com)",
+				Required:    true,
+			},
+			"administrator_api_token": {
+				Type:        schema.TypeString,
+				Description: "The Okta API token used to read the users and groups of the organization",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"required_group": {
+				Type:        schema.TypeString,
+				Description: "The Okta group users must be in to access Morpheus",
+				Optional:    true,
+				Computed:    true,
+			},
+			"default_account_role_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the default role a user is assigned when they are in the required group or if no specific group mapping applies to the user",
+				Required:    true,
+			},
+			"enable_role_mapping_permission": {
+				Type:        schema.TypeBool,
+				Description: "When enabled, Tenant users with appropriate rights to view and edit Roles will have the ability to set role mapping for the Identity Source integration",
+				Optional:    true,
+				Computed:    true,
+			},
+			"role_mapping": {
+				Description: "The Okta to Morpheus Role mapping",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_id": {
+							Description: "The id of the Morpheus role to map to",
+							Type:        schema.TypeInt,
+							Optional:    true,
+						},
+						"role_name": {
+							Description: "The name or authority of the Morpheus role to map to",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"okta_group_name": {
+							Description: "The name of the Okta group to map to",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceOktaIdentitySourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	identitySource := oktaIdentitySourcePayload(d)
+	identitySource["config"].(map[string]interface{})["administratorAPIToken"] = d.Get("administrator_api_token").(string)
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"userSource": identitySource,
+		},
+	}
+
+	resp, err := client.CreateIdentitySource(int64(d.Get("tenant_id").(int)), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateIdentitySourceResult)
+	identitySourceResult := result.IdentitySource
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(identitySourceResult.ID))
+
+	resourceOktaIdentitySourceRead(ctx, d, meta)
+	return diags
+}
+
+func resourceOktaIdentitySourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindIdentitySourceByName(name)
+	} else if id != "" {
+		resp, err = client.GetIdentitySource(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Identity source cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data, the api token is masked in the response so the configured value is kept
+	result := resp.Result.(*morpheus.GetIdentitySourceResult)
+	identitySource := result.IdentitySource
+	d.SetId(int64ToString(identitySource.ID))
+	d.Set("name", identitySource.Name)
+	d.Set("description", identitySource.Description)
+	d.Set("url", identitySource.Config.URL)
+	d.Set("required_group", identitySource.Config.RequiredGroup)
+	d.Set("default_account_role_id", identitySource.DefaultAccountRole.ID)
+	d.Set("enable_role_mapping_permission", identitySource.AllowCustomMappings)
+
+	var roleMappingPayload []map[string]interface{}
+
+	for _, roleMapping := range identitySource.RoleMappings {
+		roleOutput := make(map[string]interface{})
+		roleOutput["okta_group_name"] = roleMapping.SourceRoleName
+		roleOutput["role_id"] = roleMapping.MappedRole.ID
+		roleOutput["role_name"] = roleMapping.MappedRole.Authority
+		roleMappingPayload = append(roleMappingPayload, roleOutput)
+	}
+	d.Set("role_mapping", roleMappingPayload)
+	return diags
+}
+
+func resourceOktaIdentitySourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	identitySource := oktaIdentitySourcePayload(d)
+	if d.HasChange("administrator_api_token") {
+		identitySource["config"].(map[string]interface{})["administratorAPIToken"] = d.Get("administrator_api_token").(string)
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"userSource": identitySource,
+		},
+	}
+
+	resp, err := client.UpdateIdentitySource(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateIdentitySourceResult)
+	identitySourceResult := result.IdentitySource
+
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(identitySourceResult.ID))
+	return resourceOktaIdentitySourceRead(ctx, d, meta)
+}
+
+func resourceOktaIdentitySourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteIdentitySource(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func oktaIdentitySourcePayload(d *schema.ResourceData) map[string]interface{} {
+	identitySource := make(map[string]interface{})
+
+	identitySource["name"] = d.Get("name").(string)
+	identitySource["description"] = d.Get("description").(string)
+	identitySource["type"] = "okta"
+
+	config := make(map[string]interface{})
+	config["url"] = d.Get("url").(string)
+	config["requiredGroup"] = d.Get("required_group").(string)
+	identitySource["config"] = config
+
+	defaultAccountRole := make(map[string]interface{})
+	defaultAccountRole["id"] = d.Get("default_account_role_id").(int)
+	identitySource["defaultAccountRole"] = defaultAccountRole
+
+	// Role Mappings
+	identitySource["roleMappings"] = parseIdentitySourceRoleMappings(d.Get("role_mapping").(*schema.Set), "okta_group_name", "")
+	identitySource["allowCustomMappings"] = d.Get("enable_role_mapping_permission").(bool)
+	return identitySource
+}
@@ -67,11 +67,13 @@ func resourceOperationalWorkflow() *schema.Resource {
 				Default:      "private",
 			},
 			"task_ids": {
-				Type:        schema.TypeList,
-				Description: "A list of tasks ids associated with the operational workflow",
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Type:          schema.TypeList,
+				Description:   "A list of tasks ids associated with the operational workflow",
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeInt},
+				ConflictsWith: []string{"task"},
 			},
+			"task": workflowTaskSchema("operational", []string{"operation"}, "operation"),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -88,17 +90,7 @@ func resourceOperationalWorkflowCreate(ctx context.Context, d *schema.ResourceDa
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	// tasks
-	var tasks []map[string]interface{}
-	if d.Get("task_ids") != nil {
-		taskList := d.Get("task_ids").([]interface{})
-		// iterate over the array of tasks
-		for i := 0; i < len(taskList); i++ {
-			row := make(map[string]interface{})
-			row["taskId"] = taskList[i]
-			row["taskPhase"] = "operation"
-			tasks = append(tasks, row)
-		}
-	}
+	tasks := operationalWorkflowTasksPayload(d)
 
 	labelsPayload := make([]string, 0)
 	if attr, ok := d.GetOk("labels"); ok {
@@ -191,7 +183,21 @@ func resourceOperationalWorkflowRead(ctx context.Context, d *schema.ResourceData
 			}
 		}
 		d.Set("option_types", optionTypes)
-		d.Set("task_ids", workflow.Tasks)
+		tasks, err := parseWorkflowTasks(d, resp.Body)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if _, ok := d.GetOk("task_ids"); ok {
+			var taskIds []int64
+			for _, task := range tasks {
+				if taskId, ok := task["task_id"]; ok {
+					taskIds = append(taskIds, taskId.(int64))
+				}
+			}
+			d.Set("task_ids", taskIds)
+		} else {
+			d.Set("task", tasks)
+		}
 		d.Set("visibility", workflow.Visibility)
 		d.Set("allow_custom_config", workflow.AllowCustomConfig)
 		d.Set("platform", workflow.Platform)
@@ -209,17 +215,7 @@ func resourceOperationalWorkflowUpdate(ctx context.Context, d *schema.ResourceDa
 	description := d.Get("description").(string)
 
 	// tasks
-	var tasks []map[string]interface{}
-	if d.Get("task_ids") != nil {
-		taskList := d.Get("task_ids").([]interface{})
-		// iterate over the array of tasks
-		for i := 0; i < len(taskList); i++ {
-			row := make(map[string]interface{})
-			row["taskId"] = taskList[i]
-			row["taskPhase"] = "operation"
-			tasks = append(tasks, row)
-		}
-	}
+	tasks := operationalWorkflowTasksPayload(d)
 
 	labelsPayload := make([]string, 0)
 	if attr, ok := d.GetOk("labels"); ok {
@@ -279,3 +275,22 @@ func resourceOperationalWorkflowDelete(ctx context.Context, d *schema.ResourceDa
 	d.SetId("")
 	return diags
 }
+
+// operationalWorkflowTasksPayload builds the tasks of the workflow payload from the
+// task blocks or the list of task ids
+func operationalWorkflowTasksPayload(d *schema.ResourceData) []map[string]interface{} {
+	if _, ok := d.GetOk("task_ids"); !ok {
+		return workflowTasksPayload(d)
+	}
+	tasks := make([]map[string]interface{}, 0)
+	taskList := d.Get("task_ids").([]interface{})
+	// iterate over the array of tasks
+	for i := 0; i < len(taskList); i++ {
+		row := make(map[string]interface{})
+		row["taskId"] = taskList[i]
+		row["taskPhase"] = "operation"
+		row["taskOrder"] = i
+		tasks = append(tasks, row)
+	}
+	return tasks
+}
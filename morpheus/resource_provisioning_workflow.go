@@ -2,7 +2,10 @@ package morpheus
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"sort"
+	"strings"
 
 	"log"
 
@@ -58,26 +61,7 @@ func resourceProvisioningWorkflow() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
 				Default:      "private",
 			},
-			"task": {
-				Type:        schema.TypeList,
-				Description: "A list of tasks associated with the provisioning workflow",
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"task_id": {
-							Type:        schema.TypeInt,
-							Description: "The ID of the task to associate with the provisioning workflow",
-							Required:    true,
-						},
-						"task_phase": {
-							Type:         schema.TypeString,
-							Description:  "The phase that the task is executed (configure, price, preProvision, provision, postProvision, start, stop, preDeploy, deploy, reconfigure, teardown, shutdown, startup)",
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"configure", "price", "preProvision", "provision", "postProvision", "start", "stop", "preDeploy", "deploy", "reconfigure", "teardown", "shutdown", "startup"}, false),
-						},
-					},
-				},
-			},
+			"task": workflowTaskSchema("provisioning", []string{"configure", "price", "preProvision", "provision", "postProvision", "start", "stop", "preDeploy", "deploy", "reconfigure", "teardown", "shutdown", "startup"}, ""),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -94,18 +78,7 @@ func resourceProvisioningWorkflowCreate(ctx context.Context, d *schema.ResourceD
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	// tasks
-	var tasks []map[string]interface{}
-	if d.Get("task") != nil {
-		taskList := d.Get("task").([]interface{})
-		// iterate over the array of tasks
-		for i := 0; i < len(taskList); i++ {
-			row := make(map[string]interface{})
-			taskconfig := taskList[i].(map[string]interface{})
-			row["taskId"] = taskconfig["task_id"]
-			row["taskPhase"] = taskconfig["task_phase"]
-			tasks = append(tasks, row)
-		}
-	}
+	tasks := workflowTasksPayload(d)
 
 	labelsPayload := make([]string, 0)
 	if attr, ok := d.GetOk("labels"); ok {
@@ -182,23 +155,9 @@ func resourceProvisioningWorkflowRead(ctx context.Context, d *schema.ResourceDat
 	workflow := result.TaskSet
 
 	// Tasks
-	var tasks []map[string]interface{}
-	var taskOrderList []TaskOrder
-	if len(workflow.TaskSetTasks) != 0 {
-		for _, task := range workflow.TaskSetTasks {
-			var data TaskOrder
-			data.ID = task.Task.ID
-			data.Phase = task.TaskPhase
-			data.Order = task.TaskOrder
-			taskOrderList = append(taskOrderList, data)
-		}
-		sort.Slice(taskOrderList, func(i, j int) bool { return taskOrderList[i].Order < taskOrderList[j].Order })
-		for _, task := range taskOrderList {
-			tag := make(map[string]interface{})
-			tag["task_phase"] = task.Phase
-			tag["task_id"] = task.ID
-			tasks = append(tasks, tag)
-		}
+	tasks, err := parseWorkflowTasks(d, resp.Body)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	if workflow != nil {
 		d.SetId(int64ToString(workflow.ID))
@@ -225,18 +184,7 @@ func resourceProvisioningWorkflowUpdate(ctx context.Context, d *schema.ResourceD
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	// tasks
-	var tasks []map[string]interface{}
-	if d.Get("task") != nil {
-		taskList := d.Get("task").([]interface{})
-		// iterate over the array of tasks
-		for i := 0; i < len(taskList); i++ {
-			row := make(map[string]interface{})
-			taskconfig := taskList[i].(map[string]interface{})
-			row["taskId"] = taskconfig["task_id"]
-			row["taskPhase"] = taskconfig["task_phase"]
-			tasks = append(tasks, row)
-		}
-	}
+	tasks := workflowTasksPayload(d)
 
 	labelsPayload := make([]string, 0)
 	if attr, ok := d.GetOk("labels"); ok {
@@ -294,8 +242,110 @@ func resourceProvisioningWorkflowDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
-type TaskOrder struct {
-	Order int64  `json:"order"`
-	ID    int64  `json:"id"`
-	Phase string `json:"phase"`
+// workflowTaskSchema returns the ordered task blocks of a workflow, the steps are
+// sent to Morpheus in the order of the blocks so reordering them is a change
+func workflowTaskSchema(workflowType string, phases []string, defaultPhase string) *schema.Schema {
+	taskPhase := &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  fmt.Sprintf("The phase that the task is executed (%s)", strings.Join(phases, ", ")),
+		ValidateFunc: validation.StringInSlice(phases, false),
+	}
+	if defaultPhase != "" {
+		taskPhase.Optional = true
+		taskPhase.Default = defaultPhase
+	} else {
+		taskPhase.Required = true
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: fmt.Sprintf("An ordered list of the steps of the %s workflow, a nested workflow is run as a step through a nested workflow task", workflowType),
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"task_id": {
+					Type:        schema.TypeInt,
+					Description: fmt.Sprintf("The ID of the task to associate with the %s workflow, i.e. the ID of a morpheus_nested_workflow_task to run a nested workflow", workflowType),
+					Required:    true,
+				},
+				"task_phase": taskPhase,
+				"inputs": {
+					Type:        schema.TypeMap,
+					Description: "The option type input values passed to the step, keyed by the field name of the option type",
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+// workflowTasksPayload builds the tasks of the workflow payload from the task blocks,
+// the position of the block is sent as the order of the step
+func workflowTasksPayload(d *schema.ResourceData) []map[string]interface{} {
+	tasks := make([]map[string]interface{}, 0)
+	taskList := d.Get("task").([]interface{})
+	for i, item := range taskList {
+		taskconfig := item.(map[string]interface{})
+		row := make(map[string]interface{})
+		row["taskId"] = taskconfig["task_id"]
+		row["taskPhase"] = taskconfig["task_phase"]
+		row["taskOrder"] = i
+		if inputs := taskconfig["inputs"].(map[string]interface{}); len(inputs) > 0 {
+			row["taskOptions"] = inputs
+		}
+		tasks = append(tasks, row)
+	}
+	return tasks
+}
+
+// parseWorkflowTasks returns the task blocks of the workflow sorted by the order of the
+// steps, only the inputs configured on the step in the same position are kept
+func parseWorkflowTasks(d *schema.ResourceData, body []byte) ([]map[string]interface{}, error) {
+	var payload WorkflowTaskSetTasksPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	taskSetTasks := payload.TaskSet.TaskSetTasks
+	sort.SliceStable(taskSetTasks, func(i, j int) bool { return taskSetTasks[i].TaskOrder < taskSetTasks[j].TaskOrder })
+
+	configured := d.Get("task").([]interface{})
+	tasks := make([]map[string]interface{}, 0)
+	for i, taskSetTask := range taskSetTasks {
+		var configuredInputs map[string]interface{}
+		if i < len(configured) {
+			if taskconfig, ok := configured[i].(map[string]interface{}); ok {
+				configuredInputs = taskconfig["inputs"].(map[string]interface{})
+			}
+		}
+		inputs := make(map[string]interface{})
+		for key, value := range taskSetTask.TaskOptions {
+			if value == nil {
+				continue
+			}
+			if _, ok := configuredInputs[key]; ok || len(configured) == 0 {
+				inputs[key] = fmt.Sprintf("%v", value)
+			}
+		}
+
+		tag := make(map[string]interface{})
+		tag["task_phase"] = taskSetTask.TaskPhase
+		tag["task_id"] = taskSetTask.Task.ID
+		tag["inputs"] = inputs
+		tasks = append(tasks, tag)
+	}
+	return tasks, nil
+}
+
+type WorkflowTaskSetTasksPayload struct {
+	TaskSet struct {
+		TaskSetTasks []struct {
+			TaskPhase string `json:"taskPhase"`
+			TaskOrder int64  `json:"taskOrder"`
+			Task      struct {
+				ID int64 `json:"id"`
+			} `json:"task"`
+			TaskOptions map[string]interface{} `json:"taskOptions"`
+		} `json:"taskSetTasks"`
+	} `json:"taskSet"`
 }
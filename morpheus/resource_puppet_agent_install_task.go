@@ -0,0 +1,333 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourcePuppetAgentInstallTask() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus puppet agent install task resource",
+		CreateContext: resourcePuppetAgentInstallTaskCreate,
+		ReadContext:   resourcePuppetAgentInstallTaskRead,
+		UpdateContext: resourcePuppetAgentInstallTaskUpdate,
+		DeleteContext: resourcePuppetAgentInstallTaskDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the puppet agent install task",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the puppet agent install task",
+				Required:    true,
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Description: "The code of the puppet agent install task",
+				Optional:    true,
+				Computed:    true,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "The organization labels associated with the task (Only supported on Morpheus 5.5.3 or higher)",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"puppet_master_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the puppet integration the agent is registered with",
+				Required:    true,
+			},
+			"puppet_environment": {
+				Type:        schema.TypeString,
+				Description: "The puppet environment the node is assigned to (production, development, etc.)",
+				Optional:    true,
+				Computed:    true,
+			},
+			"puppet_node_name": {
+				Type:        schema.TypeString,
+				Description: "The name the node is registered with on the puppet master, defaults to the instance hostname",
+				Optional:    true,
+				Computed:    true,
+			},
+			"execute_target": {
+				Type:         schema.TypeString,
+				Description:  "The execute target of the puppet agent install (resource, remote)",
+				ValidateFunc: validation.StringInSlice([]string{"resource", "remote"}, false),
+				Optional:     true,
+				Default:      "resource",
+			},
+			"remote_target_host": {
+				Type:        schema.TypeString,
+				Description: "The hostname or ip address of the remote target",
+				Optional:    true,
+				Computed:    true,
+			},
+			"remote_target_port": {
+				Type:        schema.TypeString,
+				Description: "The port used to connect to the remote target",
+				Optional:    true,
+				Computed:    true,
+			},
+			"remote_target_username": {
+				Type:        schema.TypeString,
+				Description: "The username of the user account used to authenticate to the remote target",
+				Optional:    true,
+				Computed:    true,
+			},
+			"remote_target_password": {
+				Type:        schema.TypeString,
+				Description: "The password of the user account used to authenticate to the remote target",
+				Optional:    true,
+				Sensitive:   true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+				Computed: true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "The visibility of the task (private or public)",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"retryable": {
+				Type:        schema.TypeBool,
+				Description: "Whether to retry the task if there is a failure",
+				Optional:    true,
+				Default:     false,
+			},
+			"retry_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of times to retry the task if there is a failure",
+				Optional:    true,
+				Default:     5,
+			},
+			"retry_delay_seconds": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds to wait between retry attempts",
+				Optional:    true,
+				Default:     10,
+			},
+			"allow_custom_config": {
+				Type:        schema.TypeBool,
+				Description: "Custom configuration data to pass during the execution of the puppet agent install",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourcePuppetAgentInstallTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	taskOptions := puppetAgentInstallTaskOptions(d)
+	if d.Get("remote_target_host") != "" {
+		taskOptions["host"] = d.Get("remote_target_host")
+	}
+	if d.Get("remote_target_port") != "" {
+		taskOptions["port"] = d.Get("remote_target_port")
+	}
+	if d.Get("remote_target_username") != "" {
+		taskOptions["username"] = d.Get("remote_target_username")
+	}
+	if d.Get("remote_target_password") != "" {
+		taskOptions["password"] = d.Get("remote_target_password")
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"task": puppetAgentInstallTaskPayload(d, taskOptions),
+		},
+	}
+	resp, err := client.CreateTask(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateTaskResult)
+	task := result.Task
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(task.ID))
+	log.Printf("Task ID: %s", int64ToString(task.ID))
+
+	resourcePuppetAgentInstallTaskRead(ctx, d, meta)
+	return diags
+}
+
+func resourcePuppetAgentInstallTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindTaskByName(name)
+	} else if id != "" {
+		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Task cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetTaskResult)
+	puppetTask := result.Task
+
+	d.SetId(int64ToString(puppetTask.ID))
+	d.Set("name", puppetTask.Name)
+	d.Set("code", puppetTask.Code)
+	d.Set("labels", puppetTask.Labels)
+	d.Set("puppet_master_id", puppetTask.TaskOptions.PuppetMasterId)
+	d.Set("puppet_environment", puppetTask.TaskOptions.PuppetEnvironment)
+	d.Set("puppet_node_name", puppetTask.TaskOptions.PuppetNodeName)
+	d.Set("execute_target", puppetTask.ExecuteTarget)
+	d.Set("remote_target_host", puppetTask.TaskOptions.Host)
+	d.Set("remote_target_port", puppetTask.TaskOptions.Port)
+	d.Set("remote_target_username", puppetTask.TaskOptions.Username)
+	d.Set("remote_target_password", puppetTask.TaskOptions.PasswordHash)
+	d.Set("visibility", puppetTask.Visibility)
+	d.Set("retryable", puppetTask.Retryable)
+	d.Set("retry_count", puppetTask.RetryCount)
+	d.Set("retry_delay_seconds", puppetTask.RetryDelaySeconds)
+	d.Set("allow_custom_config", puppetTask.AllowCustomConfig)
+	return diags
+}
+
+func resourcePuppetAgentInstallTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	taskOptions := puppetAgentInstallTaskOptions(d)
+	if d.HasChange("remote_target_host") {
+		taskOptions["host"] = d.Get("remote_target_host")
+	}
+	if d.HasChange("remote_target_port") {
+		taskOptions["port"] = d.Get("remote_target_port")
+	}
+	if d.HasChange("remote_target_username") {
+		taskOptions["username"] = d.Get("remote_target_username")
+	}
+	if d.HasChange("remote_target_password") {
+		taskOptions["password"] = d.Get("remote_target_password")
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"task": puppetAgentInstallTaskPayload(d, taskOptions),
+		},
+	}
+	resp, err := client.UpdateTask(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateTaskResult)
+	puppetTask := result.Task
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(puppetTask.ID))
+	return resourcePuppetAgentInstallTaskRead(ctx, d, meta)
+}
+
+func resourcePuppetAgentInstallTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteTask(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func puppetAgentInstallTaskOptions(d *schema.ResourceData) map[string]interface{} {
+	taskOptions := make(map[string]interface{})
+	taskOptions["puppetMasterId"] = strconv.Itoa(d.Get("puppet_master_id").(int))
+	taskOptions["puppetEnvironment"] = d.Get("puppet_environment")
+	taskOptions["puppetNodeName"] = d.Get("puppet_node_name")
+	return taskOptions
+}
+
+func puppetAgentInstallTaskPayload(d *schema.ResourceData, taskOptions map[string]interface{}) map[string]interface{} {
+	labelsPayload := make([]string, 0)
+	if attr, ok := d.GetOk("labels"); ok {
+		for _, s := range attr.(*schema.Set).List() {
+			labelsPayload = append(labelsPayload, s.(string))
+		}
+	}
+
+	return map[string]interface{}{
+		"name":   d.Get("name").(string),
+		"code":   d.Get("code").(string),
+		"labels": labelsPayload,
+		"taskType": map[string]interface{}{
+			"code": "puppetTask",
+		},
+		"taskOptions":       taskOptions,
+		"executeTarget":     d.Get("execute_target").(string),
+		"visibility":        d.Get("visibility"),
+		"retryable":         d.Get("retryable"),
+		"retryCount":        d.Get("retry_count"),
+		"retryDelaySeconds": d.Get("retry_delay_seconds"),
+		"allowCustomConfig": d.Get("allow_custom_config"),
+	}
+}
@@ -0,0 +1,69 @@
+package morpheus
+
+import (
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceRoleCatalogItemTypePermission() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus role catalog item type permission resource, the resource manages the access a user or tenant role grants to a single catalog item type. The access of the catalog item type is reset to the default access of the role when the resource is destroyed",
+		CreateContext: roleCatalogItemTypePermissionType.createContext(),
+		ReadContext:   roleCatalogItemTypePermissionType.readContext(),
+		UpdateContext: roleCatalogItemTypePermissionType.updateContext(),
+		DeleteContext: roleCatalogItemTypePermissionType.deleteContext(),
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the role catalog item type permission, the id of the catalog item type",
+				Computed:    true,
+			},
+			"role_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the user or tenant role the catalog item type permission is granted by",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"catalog_item_type_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the catalog item type",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"access": {
+				Type:         schema.TypeString,
+				Description:  "The level of access granted to the catalog item type (default, full, none)",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"default", "full", "none"}, false),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the catalog item type",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importNestedResourceState("role_id"),
+		},
+	}
+}
+
+var roleCatalogItemTypePermissionType = rolePermissionType{
+	name:          "Catalog item type",
+	attribute:     "catalog_item_type_id",
+	bodyKey:       "catalogItemTypeId",
+	numeric:       true,
+	revokedAccess: "default",
+	update: func(client *morpheus.Client, roleId int64, req *morpheus.Request) (*morpheus.Response, error) {
+		return client.UpdateRoleCatalogItemTypeAccess(roleId, req)
+	},
+	permissions: func(result *morpheus.GetRoleResult) []rolePermission {
+		var permissions []rolePermission
+		for _, catalogItemType := range result.CatalogItemTypePermissions {
+			permissions = append(permissions, rolePermission{ID: int64ToString(catalogItemType.ID), Name: catalogItemType.Name, Access: catalogItemType.Access})
+		}
+		return permissions
+	},
+}
@@ -0,0 +1,69 @@
+package morpheus
+
+import (
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceRoleCloudPermission() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus role cloud permission resource, the resource manages the access a user or tenant role grants to a single cloud. The access of the cloud is reset to the default access of the role when the resource is destroyed",
+		CreateContext: roleCloudPermissionType.createContext(),
+		ReadContext:   roleCloudPermissionType.readContext(),
+		UpdateContext: roleCloudPermissionType.updateContext(),
+		DeleteContext: roleCloudPermissionType.deleteContext(),
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the role cloud permission, the id of the cloud",
+				Computed:    true,
+			},
+			"role_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the user or tenant role the cloud permission is granted by",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the cloud",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"access": {
+				Type:         schema.TypeString,
+				Description:  "The level of access granted to the cloud (default, full, read, none)",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"default", "full", "read", "none"}, false),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the cloud",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importNestedResourceState("role_id"),
+		},
+	}
+}
+
+var roleCloudPermissionType = rolePermissionType{
+	name:          "Cloud",
+	attribute:     "cloud_id",
+	bodyKey:       "cloudId",
+	numeric:       true,
+	revokedAccess: "default",
+	update: func(client *morpheus.Client, roleId int64, req *morpheus.Request) (*morpheus.Response, error) {
+		return client.UpdateRoleCloudAccess(roleId, req)
+	},
+	permissions: func(result *morpheus.GetRoleResult) []rolePermission {
+		var permissions []rolePermission
+		for _, cloud := range result.Zones {
+			permissions = append(permissions, rolePermission{ID: int64ToString(cloud.ID), Name: cloud.Name, Access: cloud.Access})
+		}
+		return permissions
+	},
+}
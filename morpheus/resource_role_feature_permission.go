@@ -0,0 +1,69 @@
+package morpheus
+
+import (
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceRoleFeaturePermission() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus role feature permission resource, the resource manages the access a user or tenant role grants to a single feature. The access of the feature is reset to none when the resource is destroyed",
+		CreateContext: roleFeaturePermissionType.createContext(),
+		ReadContext:   roleFeaturePermissionType.readContext(),
+		UpdateContext: roleFeaturePermissionType.updateContext(),
+		DeleteContext: roleFeaturePermissionType.deleteContext(),
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the role feature permission, the code of the feature",
+				Computed:    true,
+			},
+			"role_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the user or tenant role the feature permission is granted by",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Description: "The code of the feature permission (i.e. - admin-appliance)",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"access": {
+				Type:         schema.TypeString,
+				Description:  "The level of access granted to the feature permission (full, full_decrypted, group, listfiles, managerules, no, none, provision, read, rolemappings, user, view, yes)",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(featurePermissionAccessLevels, false),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the feature permission",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importNestedResourceState("role_id"),
+		},
+	}
+}
+
+var roleFeaturePermissionType = rolePermissionType{
+	name:          "Feature",
+	attribute:     "code",
+	bodyKey:       "permissionCode",
+	numeric:       false,
+	revokedAccess: "none",
+	update: func(client *morpheus.Client, roleId int64, req *morpheus.Request) (*morpheus.Response, error) {
+		return client.UpdateRoleFeaturePermission(roleId, req)
+	},
+	permissions: func(result *morpheus.GetRoleResult) []rolePermission {
+		var permissions []rolePermission
+		for _, feature := range result.FeaturePermissions {
+			permissions = append(permissions, rolePermission{ID: feature.Code, Name: feature.Name, Access: feature.Access})
+		}
+		return permissions
+	},
+}
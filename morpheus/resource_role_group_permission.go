@@ -0,0 +1,69 @@
+package morpheus
+
+import (
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceRoleGroupPermission() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus role group permission resource, the resource manages the access a user or tenant role grants to a single group. The access of the group is reset to the default access of the role when the resource is destroyed",
+		CreateContext: roleGroupPermissionType.createContext(),
+		ReadContext:   roleGroupPermissionType.readContext(),
+		UpdateContext: roleGroupPermissionType.updateContext(),
+		DeleteContext: roleGroupPermissionType.deleteContext(),
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the role group permission, the id of the group",
+				Computed:    true,
+			},
+			"role_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the user or tenant role the group permission is granted by",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the group",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"access": {
+				Type:         schema.TypeString,
+				Description:  "The level of access granted to the group (default, full, read, none)",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"default", "full", "read", "none"}, false),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the group",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importNestedResourceState("role_id"),
+		},
+	}
+}
+
+var roleGroupPermissionType = rolePermissionType{
+	name:          "Group",
+	attribute:     "group_id",
+	bodyKey:       "groupId",
+	numeric:       true,
+	revokedAccess: "default",
+	update: func(client *morpheus.Client, roleId int64, req *morpheus.Request) (*morpheus.Response, error) {
+		return client.UpdateRoleGroupAccess(roleId, req)
+	},
+	permissions: func(result *morpheus.GetRoleResult) []rolePermission {
+		var permissions []rolePermission
+		for _, group := range result.Sites {
+			permissions = append(permissions, rolePermission{ID: int64ToString(group.ID), Name: group.Name, Access: group.Access})
+		}
+		return permissions
+	},
+}
@@ -0,0 +1,298 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus security group resource",
+		CreateContext: resourceSecurityGroupCreate,
+		ReadContext:   resourceSecurityGroupRead,
+		UpdateContext: resourceSecurityGroupUpdate,
+		DeleteContext: resourceSecurityGroupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the security group",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the security group",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the security group",
+				Optional:    true,
+				Computed:    true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Determines whether the security group is visible in sub-tenants or not",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Default:      "private",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the security group is active",
+				Optional:    true,
+				Default:     true,
+			},
+			"location": {
+				Type:        schema.TypeSet,
+				Description: "The clouds the security group is scoped to, the security group is created in each cloud",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the security group location",
+							Computed:    true,
+						},
+						"cloud_id": {
+							Type:        schema.TypeInt,
+							Description: "The id of the cloud the security group is created in",
+							Required:    true,
+						},
+						"resource_pool_id": {
+							Type:        schema.TypeInt,
+							Description: "The id of the resource pool (i.e. VPC) within the cloud the security group is created in",
+							Optional:    true,
+						},
+						"external_id": {
+							Type:        schema.TypeString,
+							Description: "The id of the security group in the cloud",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"securityGroup": map[string]interface{}{
+				"name":        d.Get("name").(string),
+				"description": d.Get("description").(string),
+				"visibility":  d.Get("visibility").(string),
+				"active":      d.Get("active").(bool),
+			},
+		},
+	}
+
+	resp, err := client.CreateSecurityGroup(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateSecurityGroupResult)
+	securityGroup := result.SecurityGroup
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(securityGroup.ID))
+
+	if err := syncSecurityGroupLocations(client, securityGroup.ID, nil, d.Get("location").(*schema.Set).List()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceSecurityGroupRead(ctx, d, meta)
+	return diags
+}
+
+func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindSecurityGroupByName(name)
+	} else if id != "" {
+		resp, err = client.GetSecurityGroup(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Security group cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetSecurityGroupResult)
+	securityGroup := result.SecurityGroup
+	if securityGroup == nil {
+		return diag.Errorf("read operation: security group not found in response data") // should not happen
+	}
+
+	d.SetId(int64ToString(securityGroup.ID))
+	d.Set("name", securityGroup.Name)
+	d.Set("description", securityGroup.Description)
+	d.Set("visibility", securityGroup.Visibility)
+	d.Set("active", securityGroup.Active)
+
+	var locations []map[string]interface{}
+	for _, location := range securityGroup.Locations {
+		locations = append(locations, map[string]interface{}{
+			"id":               location.ID,
+			"cloud_id":         location.Zone.ID,
+			"resource_pool_id": location.ZonePool.ID,
+			"external_id":      location.Externalid,
+		})
+	}
+	d.Set("location", locations)
+
+	return diags
+}
+
+func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"securityGroup": map[string]interface{}{
+				"name":        d.Get("name").(string),
+				"description": d.Get("description").(string),
+				"visibility":  d.Get("visibility").(string),
+				"active":      d.Get("active").(bool),
+			},
+		},
+	}
+
+	resp, err := client.UpdateSecurityGroup(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	if d.HasChange("location") {
+		oldLocations, newLocations := d.GetChange("location")
+		if err := syncSecurityGroupLocations(client, toInt64(id), oldLocations.(*schema.Set).List(), newLocations.(*schema.Set).List()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSecurityGroupRead(ctx, d, meta)
+}
+
+func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteSecurityGroup(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// syncSecurityGroupLocations removes the cloud locations that are no longer configured
+// and creates the ones that have been added, locations cannot be updated in place
+func syncSecurityGroupLocations(client *morpheus.Client, securityGroupId int64, oldLocations []interface{}, newLocations []interface{}) error {
+	locationKey := func(location map[string]interface{}) string {
+		return fmt.Sprintf("%d:%d", location["cloud_id"].(int), location["resource_pool_id"].(int))
+	}
+
+	wanted := make(map[string]bool)
+	for _, location := range newLocations {
+		wanted[locationKey(location.(map[string]interface{}))] = true
+	}
+
+	existing := make(map[string]bool)
+	for _, location := range oldLocations {
+		locationConfig := location.(map[string]interface{})
+		key := locationKey(locationConfig)
+		if wanted[key] {
+			existing[key] = true
+			continue
+		}
+		locationId := int64(locationConfig["id"].(int))
+		if locationId == 0 {
+			continue
+		}
+		resp, err := client.DeleteSecurityGroupLocation(securityGroupId, locationId, &morpheus.Request{})
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+		log.Printf("API RESPONSE: %s", resp)
+	}
+
+	for _, location := range newLocations {
+		locationConfig := location.(map[string]interface{})
+		if existing[locationKey(locationConfig)] {
+			continue
+		}
+		securityGroupLocation := map[string]interface{}{
+			"zoneId": locationConfig["cloud_id"].(int),
+		}
+		if locationConfig["resource_pool_id"].(int) != 0 {
+			securityGroupLocation["zonePool"] = map[string]interface{}{
+				"id": locationConfig["resource_pool_id"].(int),
+			}
+		}
+		resp, err := client.CreateSecurityGroupLocation(securityGroupId, &morpheus.Request{
+			Body: map[string]interface{}{
+				"securityGroupLocation": securityGroupLocation,
+			},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+		log.Printf("API RESPONSE: %s", resp)
+	}
+	return nil
+}
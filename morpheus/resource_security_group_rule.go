@@ -0,0 +1,296 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSecurityGroupRule() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus security group rule resource",
+		CreateContext: resourceSecurityGroupRuleCreate,
+		ReadContext:   resourceSecurityGroupRuleRead,
+		UpdateContext: resourceSecurityGroupRuleUpdate,
+		DeleteContext: resourceSecurityGroupRuleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the security group rule",
+				Computed:    true,
+			},
+			"security_group_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the security group the rule is created in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the security group rule",
+				Required:    true,
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Description:  "The direction of the traffic the rule applies to (ingress, egress)",
+				Optional:     true,
+				Default:      "ingress",
+				ValidateFunc: validation.StringInSlice([]string{"ingress", "egress"}, false),
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Description:  "Whether the traffic matching the rule is allowed or denied (accept, reject)",
+				Optional:     true,
+				Default:      "accept",
+				ValidateFunc: validation.StringInSlice([]string{"accept", "reject"}, false),
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Description:  "The protocol of the traffic the rule applies to (tcp, udp, icmp, any)",
+				Optional:     true,
+				Default:      "tcp",
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "icmp", "any"}, false),
+			},
+			"port_range": {
+				Type:         schema.TypeString,
+				Description:  "The port or range of ports the rule applies to, i.e. 22 or 8000-8100",
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d+(-\d+)?$`), "port_range must be a port or a range of ports, i.e. 22 or 8000-8100"),
+			},
+			"source_type": {
+				Type:         schema.TypeString,
+				Description:  "The type of source the rule applies to (cidr, group, all, instance)",
+				Optional:     true,
+				Default:      "cidr",
+				ValidateFunc: validation.StringInSlice([]string{"cidr", "group", "all", "instance"}, false),
+			},
+			"source": {
+				Type:          schema.TypeString,
+				Description:   "The source cidr of the traffic when the source type is cidr",
+				Optional:      true,
+				ValidateFunc:  validation.IsCIDR,
+				ConflictsWith: []string{"source_group_id"},
+			},
+			"source_group_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the source security group when the source type is group",
+				Optional:      true,
+				ConflictsWith: []string{"source"},
+			},
+			"destination_type": {
+				Type:         schema.TypeString,
+				Description:  "The type of destination the rule applies to (cidr, group, all, instance)",
+				Optional:     true,
+				Default:      "instance",
+				ValidateFunc: validation.StringInSlice([]string{"cidr", "group", "all", "instance"}, false),
+			},
+			"destination": {
+				Type:          schema.TypeString,
+				Description:   "The destination cidr of the traffic when the destination type is cidr",
+				Optional:      true,
+				ValidateFunc:  validation.IsCIDR,
+				ConflictsWith: []string{"destination_group_id"},
+			},
+			"destination_group_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the destination security group when the destination type is group",
+				Optional:      true,
+				ConflictsWith: []string{"destination"},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the security group rule is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importNestedResourceState("security_group_id"),
+		},
+	}
+}
+
+func resourceSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	securityGroupId := int64(d.Get("security_group_id").(int))
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"rule": securityGroupRulePayload(d),
+		},
+	}
+
+	resp, err := client.CreateSecurityGroupRule(securityGroupId, req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var rule SecurityGroupRulePayload
+	if err := json.Unmarshal(resp.Body, &rule); err != nil {
+		return diag.FromErr(err)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(rule.Rule.ID))
+
+	resourceSecurityGroupRuleRead(ctx, d, meta)
+	return diags
+}
+
+func resourceSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	securityGroupId := int64(d.Get("security_group_id").(int))
+
+	resp, err := client.GetSecurityGroupRule(securityGroupId, toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// The sdk parses the source and destination groups as strings so parse the body directly
+	var rule SecurityGroupRulePayload
+	if err := json.Unmarshal(resp.Body, &rule); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(int64ToString(rule.Rule.ID))
+	d.Set("name", rule.Rule.Name)
+	d.Set("direction", rule.Rule.Direction)
+	d.Set("policy", rule.Rule.Policy)
+	d.Set("protocol", rule.Rule.Protocol)
+	d.Set("port_range", rule.Rule.PortRange)
+	d.Set("source_type", rule.Rule.SourceType)
+	d.Set("source", rule.Rule.Source)
+	d.Set("source_group_id", rule.Rule.SourceGroup.ID)
+	d.Set("destination_type", rule.Rule.DestinationType)
+	d.Set("destination", rule.Rule.Destination)
+	d.Set("destination_group_id", rule.Rule.DestinationGroup.ID)
+	d.Set("enabled", rule.Rule.Enabled)
+
+	return diags
+}
+
+func resourceSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+	securityGroupId := int64(d.Get("security_group_id").(int))
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"rule": securityGroupRulePayload(d),
+		},
+	}
+
+	resp, err := client.UpdateSecurityGroupRule(securityGroupId, toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceSecurityGroupRuleRead(ctx, d, meta)
+}
+
+func resourceSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	securityGroupId := int64(d.Get("security_group_id").(int))
+	resp, err := client.DeleteSecurityGroupRule(securityGroupId, toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func securityGroupRulePayload(d *schema.ResourceData) map[string]interface{} {
+	rule := make(map[string]interface{})
+	rule["name"] = d.Get("name").(string)
+	rule["ruleType"] = "customRule"
+	rule["direction"] = d.Get("direction").(string)
+	rule["policy"] = d.Get("policy").(string)
+	rule["protocol"] = d.Get("protocol").(string)
+	rule["portRange"] = d.Get("port_range").(string)
+	rule["sourceType"] = d.Get("source_type").(string)
+	rule["destinationType"] = d.Get("destination_type").(string)
+	rule["enabled"] = d.Get("enabled").(bool)
+
+	switch d.Get("source_type").(string) {
+	case "cidr":
+		rule["source"] = d.Get("source").(string)
+	case "group":
+		rule["sourceGroup"] = map[string]interface{}{
+			"id": d.Get("source_group_id").(int),
+		}
+	}
+	switch d.Get("destination_type").(string) {
+	case "cidr":
+		rule["destination"] = d.Get("destination").(string)
+	case "group":
+		rule["destinationGroup"] = map[string]interface{}{
+			"id": d.Get("destination_group_id").(int),
+		}
+	}
+	return rule
+}
+
+type SecurityGroupRulePayload struct {
+	Rule struct {
+		ID          int64  `json:"id"`
+		Name        string `json:"name"`
+		Direction   string `json:"direction"`
+		Policy      string `json:"policy"`
+		Protocol    string `json:"protocol"`
+		PortRange   string `json:"portRange"`
+		SourceType  string `json:"sourceType"`
+		Source      string `json:"source"`
+		SourceGroup struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"sourceGroup"`
+		DestinationType  string `json:"destinationType"`
+		Destination      string `json:"destination"`
+		DestinationGroup struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"destinationGroup"`
+		Enabled bool `json:"enabled"`
+	} `json:"rule"`
+}
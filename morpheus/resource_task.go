@@ -0,0 +1,456 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTask() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus task resource for any task type, including task types provided by plugins",
+		CreateContext: resourceTaskCreate,
+		ReadContext:   resourceTaskRead,
+		UpdateContext: resourceTaskUpdate,
+		DeleteContext: resourceTaskDelete,
+		CustomizeDiff: taskOptionsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the task",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the task",
+				Required:    true,
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Description: "The code of the task",
+				Optional:    true,
+				Computed:    true,
+			},
+			"task_type_code": {
+				Type:        schema.TypeString,
+				Description: "The code of the task type (i.e. script, jsTask or the code of a plugin provided task type)",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"task_options": {
+				Type:        schema.TypeMap,
+				Description: "The task type specific options keyed by the field name of the option type, the options are validated against the option types of the task type",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "The organization labels associated with the task (Only supported on Morpheus 5.5.3 or higher)",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"result_type": {
+				Type:         schema.TypeString,
+				Description:  "The expected result type (value, keyValue, json)",
+				ValidateFunc: validation.StringInSlice([]string{"value", "keyValue", "json"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"execute_target": {
+				Type:         schema.TypeString,
+				Description:  "The execute target of the task (local, remote, resource)",
+				ValidateFunc: validation.StringInSlice([]string{"local", "remote", "resource"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "The visibility of the task (private or public)",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"retryable": {
+				Type:        schema.TypeBool,
+				Description: "Whether to retry the task if there is a failure",
+				Optional:    true,
+				Default:     false,
+			},
+			"retry_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of times to retry the task if there is a failure",
+				Optional:    true,
+				Default:     5,
+			},
+			"retry_delay_seconds": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds to wait between retry attempts",
+				Optional:    true,
+				Default:     10,
+			},
+			"allow_custom_config": {
+				Type:        schema.TypeBool,
+				Description: "Custom configuration data to pass during the execution of the task",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"task": taskPayload(d),
+		},
+	}
+	resp, err := client.CreateTask(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateTaskResult)
+	task := result.Task
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(task.ID))
+	log.Printf("Task ID: %s", int64ToString(task.ID))
+
+	resourceTaskRead(ctx, d, meta)
+	return diags
+}
+
+func resourceTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindTaskByName(name)
+	} else if id != "" {
+		resp, err = client.GetTask(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Task cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetTaskResult)
+	task := result.Task
+
+	// The sdk only parses the options of the built-in task types so parse the body directly
+	var taskOptionsPayload TaskOptionsPayload
+	if err := json.Unmarshal(resp.Body, &taskOptionsPayload); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Morpheus returns the defaults of every option type, so the options that are not configured
+	// are only tracked when they differ from the default of their option type
+	taskTypePayload, err := getTaskTypeOptionTypes(client, task.TaskType.Code)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defaultValues := make(map[string]string)
+	for _, optionType := range taskTypePayload.TaskType.OptionTypes {
+		if optionType.FieldContext != "" && optionType.FieldContext != "taskOptions" && optionType.FieldContext != "taskOption" {
+			continue
+		}
+		defaultValues[optionType.FieldName] = ""
+		if optionType.DefaultValue != nil {
+			defaultValues[optionType.FieldName] = fmt.Sprintf("%v", optionType.DefaultValue)
+		}
+	}
+
+	// Secret values are masked so those keep the configured value
+	taskOptions := make(map[string]interface{})
+	configuredOptions := d.Get("task_options").(map[string]interface{})
+	for key, value := range taskOptionsPayload.Task.TaskOptions {
+		if value == nil {
+			continue
+		}
+		var stringValue string
+		switch v := value.(type) {
+		case string:
+			stringValue = v
+		case bool, float64:
+			stringValue = fmt.Sprintf("%v", v)
+		default:
+			continue
+		}
+		_, configured := configuredOptions[key]
+		if !configured {
+			if defaultValue, ok := defaultValues[key]; !ok || stringValue == defaultValue {
+				continue
+			}
+		}
+		if _, masked := taskOptionsPayload.Task.TaskOptions[key+"Hash"]; masked && configured {
+			taskOptions[key] = configuredOptions[key]
+			continue
+		}
+		taskOptions[key] = stringValue
+	}
+
+	d.SetId(int64ToString(task.ID))
+	d.Set("name", task.Name)
+	d.Set("code", task.Code)
+	d.Set("task_type_code", task.TaskType.Code)
+	d.Set("task_options", taskOptions)
+	d.Set("labels", task.Labels)
+	d.Set("result_type", task.ResultType)
+	d.Set("execute_target", task.ExecuteTarget)
+	d.Set("visibility", task.Visibility)
+	d.Set("retryable", task.Retryable)
+	d.Set("retry_count", task.RetryCount)
+	d.Set("retry_delay_seconds", task.RetryDelaySeconds)
+	d.Set("allow_custom_config", task.AllowCustomConfig)
+	return diags
+}
+
+func resourceTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"task": taskPayload(d),
+		},
+	}
+	resp, err := client.UpdateTask(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateTaskResult)
+	task := result.Task
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(task.ID))
+	return resourceTaskRead(ctx, d, meta)
+}
+
+func resourceTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteTask(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func taskPayload(d *schema.ResourceData) map[string]interface{} {
+	labelsPayload := make([]string, 0)
+	if attr, ok := d.GetOk("labels"); ok {
+		for _, s := range attr.(*schema.Set).List() {
+			labelsPayload = append(labelsPayload, s.(string))
+		}
+	}
+
+	// Morpheus keeps the options left out of the request, so the removed options are cleared
+	taskOptions := make(map[string]interface{})
+	oldOptions, newOptions := d.GetChange("task_options")
+	for key := range oldOptions.(map[string]interface{}) {
+		taskOptions[key] = nil
+	}
+	for key, value := range newOptions.(map[string]interface{}) {
+		taskOptions[key] = value
+	}
+
+	task := map[string]interface{}{
+		"name":   d.Get("name").(string),
+		"code":   d.Get("code").(string),
+		"labels": labelsPayload,
+		"taskType": map[string]interface{}{
+			"code": d.Get("task_type_code").(string),
+		},
+		"taskOptions":       taskOptions,
+		"resultType":        d.Get("result_type"),
+		"visibility":        d.Get("visibility"),
+		"retryable":         d.Get("retryable"),
+		"retryCount":        d.Get("retry_count"),
+		"retryDelaySeconds": d.Get("retry_delay_seconds"),
+		"allowCustomConfig": d.Get("allow_custom_config"),
+	}
+	if d.Get("execute_target").(string) != "" {
+		task["executeTarget"] = d.Get("execute_target").(string)
+	}
+	return task
+}
+
+// taskOptionsCustomizeDiff validates the task options against the option types of the
+// task type so unknown or missing options are reported at plan time instead of apply
+func taskOptionsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("task_type_code", "task_options") {
+		return nil
+	}
+
+	// Values computed from other resources are not known until apply
+	if !d.NewValueKnown("task_type_code") || !d.NewValueKnown("task_options") {
+		return nil
+	}
+
+	client := meta.(*morpheus.Client)
+	taskTypeCode := d.Get("task_type_code").(string)
+
+	taskTypePayload, err := getTaskTypeOptionTypes(client, taskTypeCode)
+	if err != nil {
+		return err
+	}
+
+	taskOptions := d.Get("task_options").(map[string]interface{})
+	var problems []string
+	optionTypes := make(map[string]bool)
+	var fieldNames []string
+	for _, optionType := range taskTypePayload.TaskType.OptionTypes {
+		// Only the task options are configured through the task_options map
+		if optionType.FieldContext != "" && optionType.FieldContext != "taskOptions" && optionType.FieldContext != "taskOption" {
+			continue
+		}
+		optionTypes[optionType.FieldName] = true
+		fieldNames = append(fieldNames, optionType.FieldName)
+
+		value, ok := taskOptions[optionType.FieldName]
+		if !ok || value.(string) == "" {
+			if optionType.Required && (optionType.DefaultValue == nil || optionType.DefaultValue == "") {
+				problems = append(problems, fmt.Sprintf("task_options.%s: the %s option is required by the %s task type", optionType.FieldName, optionType.FieldLabel, taskTypeCode))
+			}
+			continue
+		}
+		if optionType.VerifyPattern != "" {
+			pattern, err := regexp.Compile(optionType.VerifyPattern)
+			if err != nil {
+				log.Printf("Unable to compile the verify pattern of option type %s: %s", optionType.FieldName, err)
+				continue
+			}
+			if !pattern.MatchString(value.(string)) {
+				problems = append(problems, fmt.Sprintf("task_options.%s: %q does not match the pattern %s", optionType.FieldName, value, optionType.VerifyPattern))
+			}
+		}
+	}
+
+	sort.Strings(fieldNames)
+	for key := range taskOptions {
+		if !optionTypes[key] {
+			problems = append(problems, fmt.Sprintf("task_options.%s: %s is not an option of the %s task type, valid options are: %s", key, key, taskTypeCode, strings.Join(fieldNames, ", ")))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("invalid task options:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// getTaskTypeOptionTypes returns the option types of the task type with the given code
+func getTaskTypeOptionTypes(client *morpheus.Client, taskTypeCode string) (TaskTypeOptionTypesPayload, error) {
+	var taskTypePayload TaskTypeOptionTypesPayload
+
+	resp, err := client.ListTaskTypes(&morpheus.Request{
+		QueryParams: map[string]string{
+			"code": taskTypeCode,
+			"max":  "1000",
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return taskTypePayload, err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var taskTypeId int64
+	for _, taskType := range *resp.Result.(*morpheus.ListTaskTypesResult).TaskTypes {
+		if taskType.Code == taskTypeCode {
+			taskTypeId = taskType.ID
+		}
+	}
+	if taskTypeId == 0 {
+		return taskTypePayload, fmt.Errorf("task_type_code: task type %s does not exist", taskTypeCode)
+	}
+
+	resp, err = client.GetTaskType(taskTypeId, &morpheus.Request{})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return taskTypePayload, err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// The sdk does not include the field context of the option types so parse the body directly
+	if err := json.Unmarshal(resp.Body, &taskTypePayload); err != nil {
+		return taskTypePayload, err
+	}
+	return taskTypePayload, nil
+}
+
+type TaskOptionsPayload struct {
+	Task struct {
+		TaskOptions map[string]interface{} `json:"taskOptions"`
+	} `json:"task"`
+}
+
+type TaskTypeOptionTypesPayload struct {
+	TaskType struct {
+		OptionTypes []struct {
+			FieldName     string      `json:"fieldName"`
+			FieldLabel    string      `json:"fieldLabel"`
+			FieldContext  string      `json:"fieldContext"`
+			DefaultValue  interface{} `json:"defaultValue"`
+			Required      bool        `json:"required"`
+			VerifyPattern string      `json:"verifyPattern"`
+		} `json:"optionTypes"`
+	} `json:"taskType"`
+}
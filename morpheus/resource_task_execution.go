@@ -0,0 +1,300 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTaskExecution() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus task execution resource, the task is executed when the resource is created and any time the triggers change",
+		CreateContext: resourceTaskExecutionCreate,
+		ReadContext:   resourceJobExecutionRead,
+		DeleteContext: resourceJobExecutionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: jobExecutionSchema(map[string]*schema.Schema{
+			"task_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the task to execute",
+				Required:    true,
+				ForceNew:    true,
+			},
+		}),
+	}
+}
+
+func resourceTaskExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	taskId := d.Get("task_id").(int)
+	return executeJob(ctx, d, meta, fmt.Sprintf("%s/%d/execute", morpheus.TasksPath, taskId))
+}
+
+// jobExecutionSchema returns the attributes shared by the task and workflow execution
+// resources merged with the attributes identifying what is executed
+func jobExecutionSchema(attributes map[string]*schema.Schema) map[string]*schema.Schema {
+	executionSchema := map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the job execution",
+			Computed:    true,
+		},
+		"target_type": {
+			Type:         schema.TypeString,
+			Description:  "The type of target the job is executed against (appliance, instance, server)",
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "appliance",
+			ValidateFunc: validation.StringInSlice([]string{"appliance", "instance", "server"}, false),
+		},
+		"instance_ids": {
+			Type:          schema.TypeList,
+			Description:   "The ids of the instances the job is executed against when the target type is instance",
+			Optional:      true,
+			ForceNew:      true,
+			Elem:          &schema.Schema{Type: schema.TypeInt},
+			ConflictsWith: []string{"server_ids"},
+		},
+		"server_ids": {
+			Type:          schema.TypeList,
+			Description:   "The ids of the servers the job is executed against when the target type is server",
+			Optional:      true,
+			ForceNew:      true,
+			Elem:          &schema.Schema{Type: schema.TypeInt},
+			ConflictsWith: []string{"instance_ids"},
+		},
+		"custom_options": {
+			Type:        schema.TypeMap,
+			Description: "Custom option values passed to the execution",
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"triggers": {
+			Type:        schema.TypeMap,
+			Description: "Arbitrary values that cause the job to be executed again when they change",
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "The status of the job execution",
+			Computed:    true,
+		},
+		"status_message": {
+			Type:        schema.TypeString,
+			Description: "The status message of the job execution",
+			Computed:    true,
+		},
+		"output": {
+			Type:        schema.TypeString,
+			Description: "The output (stdout) of the job execution",
+			Computed:    true,
+		},
+		"error": {
+			Type:        schema.TypeString,
+			Description: "The error (stderr) of the job execution",
+			Computed:    true,
+		},
+		"result": {
+			Type:        schema.TypeString,
+			Description: "The result data of the job execution",
+			Computed:    true,
+		},
+		"start_date": {
+			Type:        schema.TypeString,
+			Description: "The date the job execution started",
+			Computed:    true,
+		},
+		"end_date": {
+			Type:        schema.TypeString,
+			Description: "The date the job execution finished",
+			Computed:    true,
+		},
+	}
+	for name, attribute := range attributes {
+		executionSchema[name] = attribute
+	}
+	return executionSchema
+}
+
+// executeJob executes the task or workflow at the execute path and waits for the
+// resulting job execution to finish, a failed execution fails the apply
+func executeJob(ctx context.Context, d *schema.ResourceData, meta interface{}, executePath string) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	job := map[string]interface{}{
+		"targetType":    d.Get("target_type").(string),
+		"customOptions": d.Get("custom_options").(map[string]interface{}),
+	}
+	switch d.Get("target_type").(string) {
+	case "instance":
+		job["instances"] = d.Get("instance_ids").([]interface{})
+	case "server":
+		job["servers"] = d.Get("server_ids").([]interface{})
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   executePath,
+		Body: map[string]interface{}{
+			"job": job,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var result ExecuteJobPayload
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return diag.FromErr(err)
+	}
+	if result.JobExecution.ID == 0 {
+		return diag.Errorf("execute operation: job execution not found in response data") // should not happen
+	}
+	// Successfully started the job, now set id
+	d.SetId(int64ToString(result.JobExecution.ID))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"new", "queued", "pending", "running", "in-progress"},
+		Target:  []string{"complete", "failed", "error", "cancelled", "expired"},
+		Refresh: func() (interface{}, string, error) {
+			executionDetails, err := client.GetJobExecution(result.JobExecution.ID, &morpheus.Request{})
+			if err != nil {
+				return "", "", err
+			}
+			jobExecution := executionDetails.Result.(*morpheus.GetJobExecutionResult).JobExecution
+			return jobExecution, jobExecution.Status, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		MinTimeout:   5 * time.Second,
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+
+	// Wait, catching any errors
+	jobExecution, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		if jobExecution, ok := jobExecution.(*morpheus.JobExecution); ok && jobExecution != nil {
+			setJobExecutionData(d, jobExecution)
+		}
+		return diag.Errorf("error waiting for job execution %d: %s", result.JobExecution.ID, err)
+	}
+
+	// Store the outcome before reporting a failure so the output is available in the state
+	setJobExecutionData(d, jobExecution.(*morpheus.JobExecution))
+	if status := jobExecution.(*morpheus.JobExecution).Status; status != "complete" {
+		return diag.Errorf("job execution %d finished with status %s: %s", result.JobExecution.ID, status, jobExecutionMessage(jobExecution.(*morpheus.JobExecution)))
+	}
+	return nil
+}
+
+func resourceJobExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.GetJobExecution(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			// Recreating the resource would execute the job again, the state is kept when the
+			// job execution is purged from the history
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Job execution %s not found, keeping the execution in the state", id)
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetJobExecutionResult)
+	jobExecution := result.JobExecution
+	if jobExecution == nil {
+		return diag.Errorf("read operation: job execution not found in response data") // should not happen
+	}
+	setJobExecutionData(d, jobExecution)
+	return diags
+}
+
+// resourceJobExecutionDelete only removes the execution from the state, the history
+// of job executions is kept by Morpheus
+func resourceJobExecutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	d.SetId("")
+	return diags
+}
+
+func setJobExecutionData(d *schema.ResourceData, jobExecution *morpheus.JobExecution) {
+	output := jobExecution.Process.Output
+	if output == "" {
+		// Workflow executions report the output on the event of each task
+		var outputs []string
+		for _, event := range jobExecution.Process.Events {
+			if event.Output != "" {
+				outputs = append(outputs, event.Output)
+			}
+		}
+		output = strings.Join(outputs, "\n")
+	}
+
+	errorOutput := jobExecution.Process.Error
+	if errorOutput == "" {
+		var errors []string
+		for _, event := range jobExecution.Process.Events {
+			if event.Error != "" {
+				errors = append(errors, event.Error)
+			}
+		}
+		errorOutput = strings.Join(errors, "\n")
+	}
+
+	d.Set("status", jobExecution.Status)
+	d.Set("status_message", jobExecution.StatusMessage)
+	d.Set("output", output)
+	d.Set("error", errorOutput)
+	d.Set("result", jobExecution.ResultData)
+	d.Set("start_date", jobExecution.StartDate)
+	d.Set("end_date", jobExecution.EndDate)
+}
+
+func jobExecutionMessage(jobExecution *morpheus.JobExecution) string {
+	if jobExecution.StatusMessage != "" {
+		return jobExecution.StatusMessage
+	}
+	if jobExecution.Process.Error != "" {
+		return jobExecution.Process.Error
+	}
+	for _, event := range jobExecution.Process.Events {
+		if event.Error != "" {
+			return event.Error
+		}
+	}
+	return "no error message was reported"
+}
+
+type ExecuteJobPayload struct {
+	Success      bool `json:"success"`
+	JobExecution struct {
+		ID int64 `json:"id"`
+	} `json:"jobExecution"`
+}
@@ -0,0 +1,243 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTenantAdminUser() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus tenant admin user resource, the user is created in the tenant through the tenant users API of the master tenant, so the provider credentials must be those of a master tenant user, and is assigned the configured admin role. The computed login_username can be used to configure a provider for the tenant.",
+		CreateContext: resourceTenantAdminUserCreate,
+		ReadContext:   resourceTenantAdminUserRead,
+		UpdateContext: resourceTenantAdminUserUpdate,
+		DeleteContext: resourceTenantAdminUserDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the user account",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"tenant_id": {
+				Description: "The ID of the tenant to create the user account in",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"first_name": {
+				Description: "The first name of the user account",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"last_name": {
+				Description: "The last name of the user account",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"username": {
+				Description: "The username of the user account",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"email": {
+				Description: "The email address of the user account",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"password": {
+				Description: "The Morpheus password for the user account (external password changes are not detected)",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"role_ids": {
+				Description: "A list of the user role ids granting the user account admin access within the tenant, i.e. the System Admin role when it is shared with the tenants",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"login_username": {
+				Description: "The username used to log into the tenant, prefixed with the subdomain of the tenant (subdomain\\username) when the tenant has one",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importNestedResourceState("tenant_id"),
+		},
+	}
+}
+
+func resourceTenantAdminUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	user := tenantAdminUserPayload(d)
+	user["password"] = d.Get("password").(string)
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"user": user,
+		},
+	}
+	resp, err := client.CreateSubtenantUser(int64(d.Get("tenant_id").(int)), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateSubtenantUserResult)
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.User.ID))
+
+	resourceTenantAdminUserRead(ctx, d, meta)
+	return diags
+}
+
+func resourceTenantAdminUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	tenantId := int64(d.Get("tenant_id").(int))
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/%d/users/%s", morpheus.TenantsPath, tenantId, id),
+		Result: &morpheus.GetUserResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	user := resp.Result.(*morpheus.GetUserResult).User
+	if user == nil {
+		return diag.Errorf("User not found in response data.") // should not happen
+	}
+	var roleIds []int
+	for _, role := range user.Roles {
+		roleIds = append(roleIds, int(role.ID))
+	}
+	d.SetId(int64ToString(user.ID))
+	d.Set("username", user.Username)
+	d.Set("first_name", user.FirstName)
+	d.Set("last_name", user.LastName)
+	d.Set("email", user.Email)
+	d.Set("role_ids", matchUserRoleIdsWithSchema(roleIds, d.Get("role_ids").([]interface{})))
+
+	// The subdomain of the tenant prefixes the username when logging into the tenant, the
+	// tenant is only read when the user is created or imported
+	subdomain, _, prefixed := strings.Cut(d.Get("login_username").(string), "\\")
+	if d.Get("login_username").(string) == "" {
+		tenantResp, err := client.GetTenant(tenantId, &morpheus.Request{})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", tenantResp, err)
+			return diag.FromErr(err)
+		}
+		if tenant := tenantResp.Result.(*morpheus.GetTenantResult).Tenant; tenant != nil && tenant.Subdomain != "" {
+			subdomain, prefixed = tenant.Subdomain, true
+		}
+	}
+	if prefixed {
+		d.Set("login_username", fmt.Sprintf("%s\\%s", subdomain, user.Username))
+	} else {
+		d.Set("login_username", user.Username)
+	}
+	return diags
+}
+
+func resourceTenantAdminUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	user := tenantAdminUserPayload(d)
+	if d.HasChange("password") {
+		user["password"] = d.Get("password").(string)
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("%s/%d/users/%s", morpheus.TenantsPath, d.Get("tenant_id").(int), id),
+		Body: map[string]interface{}{
+			"user": user,
+		},
+		Result: &morpheus.UpdateUserResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return resourceTenantAdminUserRead(ctx, d, meta)
+}
+
+func resourceTenantAdminUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("%s/%d/users/%s", morpheus.TenantsPath, d.Get("tenant_id").(int), id),
+		Result: &morpheus.DeleteUserResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func tenantAdminUserPayload(d *schema.ResourceData) map[string]interface{} {
+	user := map[string]interface{}{
+		"firstName": d.Get("first_name").(string),
+		"lastName":  d.Get("last_name").(string),
+		"username":  d.Get("username").(string),
+		"email":     d.Get("email").(string),
+	}
+
+	// The admin role is always sent so the user does not fall back to the default role of the tenant
+	var roles []map[string]interface{}
+	for _, roleId := range d.Get("role_ids").([]interface{}) {
+		roles = append(roles, map[string]interface{}{
+			"id": roleId,
+		})
+	}
+	user["roles"] = roles
+	return user
+}
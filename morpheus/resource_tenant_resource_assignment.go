@@ -0,0 +1,408 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTenantResourceAssignment() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus tenant resource assignment resource, the resource shares clouds, networks, datastores and resource pools of the master tenant with a tenant. The assignment is non-authoritative, tenants assigned to the resources outside of Terraform are left alone",
+		CreateContext: resourceTenantResourceAssignmentCreate,
+		ReadContext:   resourceTenantResourceAssignmentRead,
+		UpdateContext: resourceTenantResourceAssignmentUpdate,
+		DeleteContext: resourceTenantResourceAssignmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the tenant resource assignment, the id of the tenant",
+				Computed:    true,
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the tenant the resources are assigned to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"group_id": {
+				Type:         schema.TypeInt,
+				Description:  "The id of the group of the tenant the clouds are added to",
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"cloud_ids"},
+			},
+			"cloud_ids": {
+				Type:         schema.TypeSet,
+				Description:  "The ids of the public clouds added to the group of the tenant",
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeInt},
+				RequiredWith: []string{"group_id"},
+			},
+			"network_ids": {
+				Type:        schema.TypeSet,
+				Description: "The ids of the networks the tenant is granted access to",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"datastore": {
+				Type:        schema.TypeSet,
+				Description: "The datastores the tenant is granted access to",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_id": {
+							Type:        schema.TypeInt,
+							Description: "The id of the cloud of the datastore",
+							Required:    true,
+						},
+						"datastore_id": {
+							Type:        schema.TypeInt,
+							Description: "The id of the datastore",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"resource_pool": {
+				Type:        schema.TypeSet,
+				Description: "The resource pools the tenant is granted access to",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_id": {
+							Type:        schema.TypeInt,
+							Description: "The id of the cloud of the resource pool",
+							Required:    true,
+						},
+						"resource_pool_id": {
+							Type:        schema.TypeInt,
+							Description: "The id of the resource pool",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// tenantAssignmentAttributes are the attributes of the objects that are shared with
+// the tenant through the tenants list of the object
+var tenantAssignmentAttributes = []string{"network_ids", "datastore", "resource_pool"}
+
+// tenantAssignableObject is an object of the master tenant that can be shared with tenants
+type tenantAssignableObject struct {
+	// path of the object in the API
+	path string
+	// root key of the object in the request and response payloads
+	root string
+}
+
+// tenantAssignableObjects returns the objects of the attribute keyed by their path
+func tenantAssignableObjects(attribute string, items *schema.Set) map[string]tenantAssignableObject {
+	objects := make(map[string]tenantAssignableObject)
+	for _, item := range items.List() {
+		object := tenantAssignableObjectOf(attribute, item)
+		objects[object.path] = object
+	}
+	return objects
+}
+
+func tenantAssignableObjectOf(attribute string, item interface{}) tenantAssignableObject {
+	switch attribute {
+	case "datastore":
+		row := item.(map[string]interface{})
+		return tenantAssignableObject{path: fmt.Sprintf("/api/zones/%d/data-stores/%d", row["cloud_id"].(int), row["datastore_id"].(int)), root: "datastore"}
+	case "resource_pool":
+		row := item.(map[string]interface{})
+		return tenantAssignableObject{path: fmt.Sprintf("/api/zones/%d/resource-pools/%d", row["cloud_id"].(int), row["resource_pool_id"].(int)), root: "resourcePool"}
+	default:
+		return tenantAssignableObject{path: fmt.Sprintf("/api/networks/%d", item.(int)), root: "network"}
+	}
+}
+
+// tenants returns the tenants the object is shared with, the entries are kept as is
+// so settings like the default datastore of a tenant are preserved on update
+func (o tenantAssignableObject) tenants(client *morpheus.Client) ([]interface{}, *morpheus.Response, error) {
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   o.path,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	var payload map[string]map[string]interface{}
+	if err := json.Unmarshal(resp.Body, &payload); err != nil {
+		return nil, resp, err
+	}
+	tenants, _ := payload[o.root]["tenants"].([]interface{})
+	return tenants, resp, nil
+}
+
+// assign adds the tenant to or removes the tenant from the tenants of the object
+func (o tenantAssignableObject) assign(client *morpheus.Client, tenantId int, assigned bool) error {
+	tenants, resp, err := o.tenants(client)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	updated := make([]interface{}, 0, len(tenants)+1)
+	for _, tenant := range tenants {
+		if tenantPayloadId(tenant) != tenantId {
+			updated = append(updated, tenant)
+		}
+	}
+	if assigned {
+		if len(updated) < len(tenants) {
+			// the tenant is already assigned to the object
+			return nil
+		}
+		updated = append(updated, map[string]interface{}{"id": tenantId})
+	} else if len(updated) == len(tenants) {
+		// the tenant is not assigned to the object
+		return nil
+	}
+
+	resp, err = client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   o.path,
+		Body: map[string]interface{}{
+			o.root: map[string]interface{}{
+				"tenants": updated,
+			},
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return nil
+}
+
+func tenantPayloadId(tenant interface{}) int {
+	if row, ok := tenant.(map[string]interface{}); ok {
+		if id, ok := row["id"].(float64); ok {
+			return int(id)
+		}
+	}
+	return 0
+}
+
+func resourceTenantResourceAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	tenantId := d.Get("tenant_id").(int)
+	if err := updateTenantGroupClouds(d, client, nil, d.Get("cloud_ids").(*schema.Set).List()); err != nil {
+		return diag.FromErr(err)
+	}
+	for _, attribute := range tenantAssignmentAttributes {
+		for _, object := range tenantAssignableObjects(attribute, d.Get(attribute).(*schema.Set)) {
+			if err := object.assign(client, tenantId, true); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	// Successfully assigned the resources, now set id
+	d.SetId(intToString(tenantId))
+
+	resourceTenantResourceAssignmentRead(ctx, d, meta)
+	return diags
+}
+
+func resourceTenantResourceAssignmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	tenantId := toInt64(d.Id())
+	resp, err := client.GetTenant(tenantId, &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.Set("tenant_id", tenantId)
+
+	// Only the configured resources are refreshed, the resources no longer assigned
+	// to the tenant are dropped so they are assigned again
+	if groupId := d.Get("group_id").(int); groupId != 0 {
+		resp, err := client.GetSubtenantGroup(tenantId, int64(groupId), &morpheus.Request{})
+		if err != nil {
+			if resp == nil || resp.StatusCode != 404 {
+				log.Printf("API FAILURE: %s - %s", resp, err)
+				return diag.FromErr(err)
+			}
+			log.Printf("API 404: %s - %s", resp, err)
+			d.Set("cloud_ids", nil)
+		} else {
+			groupClouds := make(map[int]bool)
+			for _, cloud := range resp.Result.(*morpheus.GetSubtenantGroupsResult).Group.Clouds {
+				groupClouds[int(cloud.ID)] = true
+			}
+			var cloudIds []int
+			for _, cloudId := range d.Get("cloud_ids").(*schema.Set).List() {
+				if groupClouds[cloudId.(int)] {
+					cloudIds = append(cloudIds, cloudId.(int))
+				}
+			}
+			d.Set("cloud_ids", cloudIds)
+		}
+	}
+
+	for _, attribute := range tenantAssignmentAttributes {
+		var assigned []interface{}
+		for _, item := range d.Get(attribute).(*schema.Set).List() {
+			object := tenantAssignableObjectOf(attribute, item)
+			tenants, resp, err := object.tenants(client)
+			if err != nil {
+				if resp != nil && resp.StatusCode == 404 {
+					// the object has been deleted
+					log.Printf("API 404: %s - %s", resp, err)
+					continue
+				}
+				log.Printf("API FAILURE: %s - %s", resp, err)
+				return diag.FromErr(err)
+			}
+			for _, tenant := range tenants {
+				if tenantPayloadId(tenant) == int(tenantId) {
+					assigned = append(assigned, item)
+					break
+				}
+			}
+		}
+		d.Set(attribute, assigned)
+	}
+	return diags
+}
+
+func resourceTenantResourceAssignmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	tenantId := d.Get("tenant_id").(int)
+
+	if d.HasChange("cloud_ids") {
+		oldClouds, newClouds := d.GetChange("cloud_ids")
+		removed := oldClouds.(*schema.Set).Difference(newClouds.(*schema.Set)).List()
+		added := newClouds.(*schema.Set).Difference(oldClouds.(*schema.Set)).List()
+		if err := updateTenantGroupClouds(d, client, removed, added); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for _, attribute := range tenantAssignmentAttributes {
+		if !d.HasChange(attribute) {
+			continue
+		}
+		oldValue, newValue := d.GetChange(attribute)
+		oldObjects := tenantAssignableObjects(attribute, oldValue.(*schema.Set))
+		newObjects := tenantAssignableObjects(attribute, newValue.(*schema.Set))
+		for path, object := range oldObjects {
+			if _, ok := newObjects[path]; !ok {
+				if err := object.assign(client, tenantId, false); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+		for path, object := range newObjects {
+			if _, ok := oldObjects[path]; !ok {
+				if err := object.assign(client, tenantId, true); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+	}
+	return resourceTenantResourceAssignmentRead(ctx, d, meta)
+}
+
+func resourceTenantResourceAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	tenantId := d.Get("tenant_id").(int)
+	if err := updateTenantGroupClouds(d, client, d.Get("cloud_ids").(*schema.Set).List(), nil); err != nil {
+		return diag.FromErr(err)
+	}
+	for _, attribute := range tenantAssignmentAttributes {
+		for _, object := range tenantAssignableObjects(attribute, d.Get(attribute).(*schema.Set)) {
+			if err := object.assign(client, tenantId, false); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	d.SetId("")
+	return diags
+}
+
+// updateTenantGroupClouds removes and adds the clouds to the group of the tenant,
+// the other clouds of the group are left alone
+func updateTenantGroupClouds(d *schema.ResourceData, client *morpheus.Client, removed []interface{}, added []interface{}) error {
+	groupId := int64(d.Get("group_id").(int))
+	if groupId == 0 || (len(removed) == 0 && len(added) == 0) {
+		return nil
+	}
+	tenantId := int64(d.Get("tenant_id").(int))
+
+	resp, err := client.GetSubtenantGroup(tenantId, groupId, &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 && len(added) == 0 {
+			return nil
+		}
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+
+	removedClouds := make(map[int64]bool)
+	for _, cloudId := range removed {
+		removedClouds[int64(cloudId.(int))] = true
+	}
+	var clouds []map[string]interface{}
+	groupClouds := make(map[int64]bool)
+	for _, cloud := range resp.Result.(*morpheus.GetSubtenantGroupsResult).Group.Clouds {
+		groupClouds[cloud.ID] = true
+		if !removedClouds[cloud.ID] {
+			clouds = append(clouds, map[string]interface{}{"id": cloud.ID})
+		}
+	}
+	for _, cloudId := range added {
+		if !groupClouds[int64(cloudId.(int))] {
+			clouds = append(clouds, map[string]interface{}{"id": cloudId.(int)})
+		}
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"group": map[string]interface{}{
+				"zones": clouds,
+			},
+		},
+	}
+	resp, err = client.UpdateSubtenantGroupZones(tenantId, groupId, req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return nil
+}
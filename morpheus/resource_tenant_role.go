@@ -19,6 +19,7 @@ func resourceTenantRole() *schema.Resource {
 		ReadContext:   resourceTenantRoleRead,
 		UpdateContext: resourceTenantRoleUpdate,
 		DeleteContext: resourceTenantRoleDelete,
+		CustomizeDiff: permissionSetCustomizeDiff("account"),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -0,0 +1,290 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// UserAPIAccessPath is the API endpoint the access tokens of the authenticated user are managed with
+const UserAPIAccessPath = "/api/user-settings/api-access"
+
+func resourceUserAPIToken() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus user API access token resource, the token is issued to the user with the user's credentials, i.e. for a service account used by CI. Destroying the resource revokes every token the user holds for the API client, including tokens issued outside of Terraform, as Morpheus only revokes the tokens of a user per API client. The token is renewed with the refresh token once it is within renew_before_days of expiring. The expiration of the token can not be set per token, it is the access token validity of the API client configured on the appliance",
+		CreateContext: resourceUserAPITokenCreate,
+		ReadContext:   resourceUserAPITokenRead,
+		UpdateContext: resourceUserAPITokenUpdate,
+		DeleteContext: resourceUserAPITokenDelete,
+		CustomizeDiff: resourceUserAPITokenCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the user API token in the <username>:<client id> format",
+				Computed:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username of the user the token is issued to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Description:  "The password of the user the token is issued to, the password is stored in the state but only used to issue the token so rotating it does not replace the token",
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+			},
+			"password_wo": {
+				Type:        schema.TypeString,
+				Description: "The write-only password of the user the token is issued to, the password is not stored in the plan or state (requires Terraform 1.11 or later)",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Description: "The API client the token is issued for (morph-api, morph-cli, morph-automation), destroying the resource revokes all the tokens of the user for the API client",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "morph-api",
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Description: "The scope of the token",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "write",
+			},
+			"renew_before_days": {
+				Type:         schema.TypeInt,
+				Description:  "The number of days before the token expires the token is renewed with the refresh token",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"access_token": {
+				Type:        schema.TypeString,
+				Description: "The access token",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"refresh_token": {
+				Type:        schema.TypeString,
+				Description: "The refresh token",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Description: "The time the access token expires (RFC3339)",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceUserAPITokenCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Plan the renewal of the token once it is within renew_before_days of expiring
+	if diff.Id() == "" {
+		return nil
+	}
+	expiresAt, _ := diff.GetChange("expires_at")
+	renew, err := userAPITokenRenewalDue(expiresAt.(string), diff.Get("renew_before_days").(int))
+	if err != nil {
+		return err
+	}
+	if renew {
+		log.Printf("API token %s expires at %s, renewing the token", diff.Id(), expiresAt)
+		for _, key := range []string{"access_token", "refresh_token", "expires_at"} {
+			if err := diff.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func resourceUserAPITokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	username := d.Get("username").(string)
+	clientId := d.Get("client_id").(string)
+
+	// The write-only password is only available in the configuration
+	password := d.Get("password").(string)
+	if rawPassword, rawDiags := d.GetRawConfigAt(cty.GetAttrPath("password_wo")); rawDiags.HasError() {
+		return rawDiags
+	} else if rawPassword.Type().Equals(cty.String) && !rawPassword.IsNull() {
+		password = rawPassword.AsString()
+	}
+
+	// The token is issued with the credentials of the user instead of the provider's token
+	token, err := issueUserAPIToken(client, map[string]string{
+		"client_id":  clientId,
+		"grant_type": "password",
+		"scope":      d.Get("scope").(string),
+		"username":   username,
+	}, map[string]string{
+		"password": password,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Successfully issued the token, now set id
+	d.SetId(fmt.Sprintf("%s:%s", username, clientId))
+	setUserAPIToken(d, token)
+
+	resourceUserAPITokenRead(ctx, d, meta)
+	return diags
+}
+
+func resourceUserAPITokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	expiresAt, err := time.Parse(time.RFC3339, d.Get("expires_at").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if time.Now().After(expiresAt) {
+		// The expired token is renewed with the refresh token during the next apply
+		log.Printf("API token %s expired at %s", d.Id(), expiresAt)
+		return diags
+	}
+
+	// Check the token has not been revoked
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   "/api/whoami",
+		Headers: map[string]string{
+			"Authorization": "Bearer " + d.Get("access_token").(string),
+		},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 401 {
+			log.Printf("API 401: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	return diags
+}
+
+func resourceUserAPITokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// The password and renew_before_days are not sent, only a renewal planned by marking the
+	// access token unknown issues a new token
+	if !d.GetRawPlan().GetAttr("access_token").IsKnown() {
+		refreshToken, _ := d.GetChange("refresh_token")
+		token, err := issueUserAPIToken(client, map[string]string{
+			"client_id":  d.Get("client_id").(string),
+			"grant_type": "refresh_token",
+			"scope":      d.Get("scope").(string),
+		}, map[string]string{
+			"refresh_token": refreshToken.(string),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		setUserAPIToken(d, token)
+	}
+	return resourceUserAPITokenRead(ctx, d, meta)
+}
+
+func resourceUserAPITokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// The token is revoked on behalf of the user it was issued to, Morpheus revokes all the
+	// tokens of the user for the API client
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   UserAPIAccessPath,
+		QueryParams: map[string]string{
+			"clientId": d.Get("client_id").(string),
+		},
+		Headers: map[string]string{
+			"Authorization": "Bearer " + d.Get("access_token").(string),
+		},
+	})
+	if err != nil {
+		if resp != nil && (resp.StatusCode == 401 || resp.StatusCode == 404) {
+			log.Printf("API %d: %s - %s", resp.StatusCode, resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// issueUserAPIToken requests a token from the oauth endpoint with the password or refresh token grant
+func issueUserAPIToken(client *morpheus.Client, queryParams map[string]string, formData map[string]string) (*morpheus.LoginResult, error) {
+	resp, err := client.Execute(&morpheus.Request{
+		Method:            "POST",
+		Path:              "/oauth/token",
+		QueryParams:       queryParams,
+		FormData:          formData,
+		SkipLogin:         true,
+		SkipAuthorization: true,
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return nil, err
+	}
+
+	var token morpheus.LoginResult
+	if err := json.Unmarshal(resp.Body, &token); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("access token not found in response data") // should not happen
+	}
+	return &token, nil
+}
+
+// userAPITokenRenewalDue returns whether the token is within renewBeforeDays of expiring
+func userAPITokenRenewalDue(expiresAt string, renewBeforeDays int) (bool, error) {
+	if expiresAt == "" {
+		return false, nil
+	}
+	expiresAtTime, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false, err
+	}
+	return time.Now().After(expiresAtTime.AddDate(0, 0, -renewBeforeDays)), nil
+}
+
+func setUserAPIToken(d *schema.ResourceData, token *morpheus.LoginResult) {
+	d.Set("access_token", token.AccessToken)
+	d.Set("refresh_token", token.RefreshToken)
+	d.Set("expires_at", time.Now().Add(time.Duration(token.ExpiresIn)*time.Second).UTC().Format(time.RFC3339))
+}
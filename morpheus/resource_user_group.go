@@ -2,6 +2,7 @@ package morpheus
 
 import (
 	"context"
+	"fmt"
 
 	"log"
 
@@ -17,6 +18,7 @@ func resourceUserGroup() *schema.Resource {
 		ReadContext:   resourceUserGroupRead,
 		UpdateContext: resourceUserGroupUpdate,
 		DeleteContext: resourceUserGroupDelete,
+		CustomizeDiff: resourceUserGroupCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -49,11 +51,17 @@ func resourceUserGroup() *schema.Resource {
 			},
 			"user_ids": {
 				Type:        schema.TypeList,
-				Description: "A list of Morpheus user IDs to add to the user group",
+				Description: "A list of Morpheus user IDs to add to the user group, conflicts with ignore_membership set to true",
 				Optional:    true,
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
+			"ignore_membership": {
+				Type:        schema.TypeBool,
+				Description: "Whether the members of the user group are left alone, set when the membership is managed with morpheus_user_group_membership resources",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -73,7 +81,9 @@ func resourceUserGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 	userGroup["description"] = d.Get("description").(string)
 	userGroup["sudoUser"] = d.Get("sudo_access").(bool)
 	userGroup["serverGroup"] = d.Get("server_group").(string)
-	userGroup["users"] = d.Get("user_ids")
+	if !d.Get("ignore_membership").(bool) {
+		userGroup["users"] = d.Get("user_ids")
+	}
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -136,15 +146,17 @@ func resourceUserGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("description", userGroup.Description)
 	d.Set("server_group", userGroup.ServerGroup)
 	d.Set("sudo_access", userGroup.SudoUser)
-	var users []int64
-	if userGroup.Users != nil {
-		// iterate over the array of tasks
-		for i := 0; i < len(userGroup.Users); i++ {
-			users = append(users, userGroup.Users[i].ID)
+	if !d.Get("ignore_membership").(bool) {
+		var users []int64
+		if userGroup.Users != nil {
+			// iterate over the array of tasks
+			for i := 0; i < len(userGroup.Users); i++ {
+				users = append(users, userGroup.Users[i].ID)
+			}
 		}
+		userIds := matchUserIdsWithSchema(users, d.Get("user_ids").([]interface{}))
+		d.Set("user_ids", userIds)
 	}
-	userIds := matchUserIdsWithSchema(users, d.Get("user_ids").([]interface{}))
-	d.Set("user_ids", userIds)
 
 	return diags
 }
@@ -159,7 +171,9 @@ func resourceUserGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	userGroup["description"] = d.Get("description").(string)
 	userGroup["sudoUser"] = d.Get("sudo_access").(bool)
 	userGroup["serverGroup"] = d.Get("server_group").(string)
-	userGroup["users"] = d.Get("user_ids")
+	if !d.Get("ignore_membership").(bool) {
+		userGroup["users"] = d.Get("user_ids")
+	}
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -229,3 +243,16 @@ func matchUserIdsWithSchema(userIds []int64, declareduserIds []interface{}) []in
 	}
 	return result
 }
+
+// resourceUserGroupCustomizeDiff rejects user_ids when the membership is left to the
+// morpheus_user_group_membership resources, ignore_membership = false is allowed with user_ids
+func resourceUserGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	if d.Get("ignore_membership").(bool) && !rawConfig.GetAttr("user_ids").IsNull() {
+		return fmt.Errorf("user_ids: conflicts with ignore_membership, the user_ids can not be set when ignore_membership is true")
+	}
+	return nil
+}
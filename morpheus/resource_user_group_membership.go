@@ -0,0 +1,172 @@
+package morpheus
+
+import (
+	"context"
+	"sync"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// userGroupMembershipMutex serializes the membership changes, the users of a group
+// can only be updated by replacing the whole list
+var userGroupMembershipMutex sync.Mutex
+
+func resourceUserGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus user group membership resource, the resource manages the membership of a single user in a user group without affecting the other members of the group",
+		CreateContext: resourceUserGroupMembershipCreate,
+		ReadContext:   resourceUserGroupMembershipRead,
+		DeleteContext: resourceUserGroupMembershipDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the user group membership, the id of the user",
+				Computed:    true,
+			},
+			"user_group_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the user group",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"user_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the user to add to the user group",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importNestedResourceState("user_group_id"),
+		},
+	}
+}
+
+func resourceUserGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	userId := int64(d.Get("user_id").(int))
+	_, err := updateUserGroupMembers(client, int64(d.Get("user_group_id").(int)), func(userIds []int64) []int64 {
+		for _, id := range userIds {
+			if id == userId {
+				return userIds
+			}
+		}
+		return append(userIds, userId)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Successfully added the user, now set id
+	d.SetId(int64ToString(userId))
+
+	resourceUserGroupMembershipRead(ctx, d, meta)
+	return diags
+}
+
+func resourceUserGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	userGroupId := int64(d.Get("user_group_id").(int))
+
+	resp, err := client.GetUserGroup(userGroupId, &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	userGroup := resp.Result.(*morpheus.GetUserGroupResult).UserGroup
+	for _, user := range userGroup.Users {
+		if user.ID == toInt64(id) {
+			d.Set("user_id", user.ID)
+			return diags
+		}
+	}
+
+	log.Printf("User %s is not a member of user group %d, forcing recreation of resource", id, userGroupId)
+	d.SetId("")
+	return diags
+}
+
+func resourceUserGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	userId := toInt64(d.Id())
+	resp, err := updateUserGroupMembers(client, int64(d.Get("user_group_id").(int)), func(userIds []int64) []int64 {
+		members := make([]int64, 0, len(userIds))
+		for _, id := range userIds {
+			if id != userId {
+				members = append(members, id)
+			}
+		}
+		return members
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
+
+// updateUserGroupMembers replaces the users of the user group with the result of the
+// update function, which is passed the ids of the current members
+func updateUserGroupMembers(client *morpheus.Client, userGroupId int64, update func([]int64) []int64) (*morpheus.Response, error) {
+	userGroupMembershipMutex.Lock()
+	defer userGroupMembershipMutex.Unlock()
+
+	resp, err := client.GetUserGroup(userGroupId, &morpheus.Request{})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return resp, err
+	}
+	userGroup := resp.Result.(*morpheus.GetUserGroupResult).UserGroup
+
+	userIds := make([]int64, 0, len(userGroup.Users))
+	for _, user := range userGroup.Users {
+		userIds = append(userIds, user.ID)
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"userGroup": map[string]interface{}{
+				"users": update(userIds),
+			},
+		},
+	}
+	resp, err = client.UpdateUserGroup(userGroupId, req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return resp, err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return resp, nil
+}
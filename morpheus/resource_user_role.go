@@ -19,6 +19,7 @@ func resourceUserRole() *schema.Resource {
 		ReadContext:   resourceUserRoleRead,
 		UpdateContext: resourceUserRoleUpdate,
 		DeleteContext: resourceUserRoleDelete,
+		CustomizeDiff: permissionSetCustomizeDiff("user"),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
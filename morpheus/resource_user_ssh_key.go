@@ -0,0 +1,216 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceUserSSHKey() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus user ssh key resource, the key pair is created and assigned to the user for accessing linux instances. Changing the key creates a new key pair and assigns it to the user",
+		CreateContext: resourceUserSSHKeyCreate,
+		ReadContext:   resourceUserSSHKeyRead,
+		DeleteContext: resourceUserSSHKeyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the key pair",
+				Computed:    true,
+			},
+			"user_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the user the key pair is assigned to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the key pair",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Description: "The public key of the key pair",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Description: "The private key of the key pair",
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				StateFunc: func(v interface{}) string {
+					h := sha256.New()
+					h.Write([]byte(v.(string)))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return sha256_hash
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+			},
+			"passphrase": {
+				Type:        schema.TypeString,
+				Description: "The passphrase for the private key of the key pair",
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Description: "The fingerprint of the key pair",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importNestedResourceState("user_id"),
+		},
+	}
+}
+
+func resourceUserSSHKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"keyPair": map[string]interface{}{
+				"name":       d.Get("name").(string),
+				"publicKey":  d.Get("public_key").(string),
+				"privateKey": d.Get("private_key").(string),
+				"passphrase": d.Get("passphrase").(string),
+			},
+		},
+	}
+
+	resp, err := client.CreateKeyPair(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateKeyPairResult)
+	keyPair := result.KeyPair
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(keyPair.ID))
+
+	// Assign the key pair to the user
+	if err := updateUserLinuxKeyPair(client, int64(d.Get("user_id").(int)), keyPair.ID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceUserSSHKeyRead(ctx, d, meta)
+	return diags
+}
+
+func resourceUserSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.GetKeyPair(toInt64(id))
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	keyPair := resp.Result.(*morpheus.GetKeyPairResult).KeyPair
+	if keyPair == nil {
+		return diag.Errorf("Key pair not found in response data.") // should not happen
+	}
+	d.SetId(int64ToString(keyPair.ID))
+	d.Set("name", keyPair.Name)
+	d.Set("public_key", keyPair.PublicKey)
+	d.Set("private_key", keyPair.PrivateKeyHash)
+	d.Set("fingerprint", keyPair.Fingerprint)
+	return diags
+}
+
+func resourceUserSSHKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	userId := int64(d.Get("user_id").(int))
+
+	// Unassign the key pair unless the user has been given another key pair in the meantime
+	resp, err := client.GetUser(userId, &morpheus.Request{})
+	if err != nil {
+		if resp == nil || resp.StatusCode != 404 {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API 404: %s - %s", resp, err)
+	} else if user := resp.Result.(*morpheus.GetUserResult).User; user != nil && user.LinuxKeyPairID == toInt64(id) {
+		if err := updateUserLinuxKeyPair(client, userId, 0); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	resp, err = client.DeleteKeyPair(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// updateUserLinuxKeyPair assigns the key pair to the user for accessing linux instances,
+// a keyPairId of 0 removes the key pair from the user
+func updateUserLinuxKeyPair(client *morpheus.Client, userId int64, keyPairId int64) error {
+	var linuxKeyPairId interface{}
+	if keyPairId != 0 {
+		linuxKeyPairId = keyPairId
+	}
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"user": map[string]interface{}{
+				"linuxKeyPairId": linuxKeyPairId,
+			},
+		},
+	}
+	resp, err := client.UpdateUser(userId, req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return nil
+}
@@ -0,0 +1,746 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWorkflowBundle() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus workflow bundle resource, the option types, tasks and workflow of a Morpheus JSON export are created or updated by their code. Objects that already exist are updated but only the objects created by the bundle are deleted with it",
+		CreateContext: resourceWorkflowBundleCreate,
+		ReadContext:   resourceWorkflowBundleRead,
+		UpdateContext: resourceWorkflowBundleUpdate,
+		DeleteContext: resourceWorkflowBundleDelete,
+		CustomizeDiff: workflowBundleCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the workflow of the bundle",
+				Computed:    true,
+			},
+			"bundle": {
+				Type:             schema.TypeString,
+				Description:      "The exported workflow in Morpheus JSON format, an object with the optionTypes and tasks lists and the workflow (or taskSet) object, the workflow references its tasks and option types by code",
+				Required:         true,
+				ValidateFunc:     validateWorkflowBundle,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"checksum": {
+				Type:        schema.TypeString,
+				Description: "The SHA-256 checksum of the bundle applied to Morpheus, the checksum is cleared when the objects of the bundle are changed outside of Terraform so the bundle is applied again",
+				Computed:    true,
+			},
+			"workflow_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the workflow of the bundle",
+				Computed:    true,
+			},
+			"workflow_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the workflow of the bundle",
+				Computed:    true,
+			},
+			"task_ids": {
+				Type:        schema.TypeMap,
+				Description: "The IDs of the tasks of the bundle keyed by task code",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"option_type_ids": {
+				Type:        schema.TypeMap,
+				Description: "The IDs of the option types of the bundle keyed by option type code",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"created_task_ids": {
+				Type:        schema.TypeSet,
+				Description: "The IDs of the tasks created by the bundle, only these tasks are deleted with the bundle. Existing tasks with the code of a bundle task are updated but left in place",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"created_option_type_ids": {
+				Type:        schema.TypeSet,
+				Description: "The IDs of the option types created by the bundle, only these option types are deleted with the bundle. Existing option types with the code of a bundle option type are updated but left in place",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"workflow_created": {
+				Type:        schema.TypeBool,
+				Description: "Whether the workflow was created by the bundle, an existing workflow is left in place when the bundle is deleted",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceWorkflowBundleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	if err := applyWorkflowBundle(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The checksum of the applied bundle is kept, changes are only detected on refresh
+	checksum := d.Get("checksum").(string)
+	resourceWorkflowBundleRead(ctx, d, meta)
+	d.Set("checksum", checksum)
+	return diags
+}
+
+func resourceWorkflowBundleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.GetTaskSet(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetTaskSetResult)
+	workflow := result.TaskSet
+	if workflow == nil {
+		return diag.Errorf("read operation: workflow not found in response data") // should not happen
+	}
+	d.Set("workflow_id", workflow.ID)
+	d.Set("workflow_name", workflow.Name)
+
+	bundle, err := parseWorkflowBundle(d.Get("bundle").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The workflow, tasks and option types deleted or changed outside of Terraform are detected
+	// by comparing them against the bundle, the checksum is cleared so the bundle is applied again
+	drifted := workflowBundleStepsChanged(bundle, workflow)
+	if !drifted {
+		changed, err := workflowBundleObjectChanged(client, fmt.Sprintf("%s/%d", morpheus.TaskSetsPath, workflow.ID), "taskSet", bundle.Workflow)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		drifted = changed
+	}
+	for _, objects := range []struct {
+		ids       map[string]interface{}
+		path      string
+		objectKey string
+		objects   []map[string]interface{}
+	}{
+		{d.Get("option_type_ids").(map[string]interface{}), morpheus.OptionTypesPath, "optionType", bundle.OptionTypes},
+		{d.Get("task_ids").(map[string]interface{}), morpheus.TasksPath, "task", bundle.Tasks},
+	} {
+		for _, object := range objects.objects {
+			if drifted {
+				break
+			}
+			code := object["code"].(string)
+			id, ok := objects.ids[code]
+			if !ok {
+				drifted = true
+				break
+			}
+			changed, err := workflowBundleObjectChanged(client, fmt.Sprintf("%s/%d", objects.path, id.(int)), objects.objectKey, object)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if changed {
+				log.Printf("The %s %s of the workflow bundle has been changed outside of Terraform", objects.objectKey, code)
+				drifted = true
+				break
+			}
+		}
+	}
+	if drifted {
+		log.Printf("The workflow bundle %s has been changed outside of Terraform", id)
+		d.Set("checksum", "")
+	}
+	return diags
+}
+
+func resourceWorkflowBundleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// The objects created by the bundle and removed from it are deleted once the workflow no
+	// longer references them
+	oldTaskIds, _ := d.GetChange("created_task_ids")
+	oldOptionTypeIds, _ := d.GetChange("created_option_type_ids")
+
+	if err := applyWorkflowBundle(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	taskIds := d.Get("created_task_ids").(*schema.Set)
+	for _, id := range oldTaskIds.(*schema.Set).List() {
+		if !taskIds.Contains(id) {
+			diags = append(diags, deleteWorkflowBundleObject(client, morpheus.TasksPath, "task", id.(int))...)
+		}
+	}
+	optionTypeIds := d.Get("created_option_type_ids").(*schema.Set)
+	for _, id := range oldOptionTypeIds.(*schema.Set).List() {
+		if !optionTypeIds.Contains(id) {
+			diags = append(diags, deleteWorkflowBundleObject(client, morpheus.OptionTypesPath, "option type", id.(int))...)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	// The checksum of the applied bundle is kept, changes are only detected on refresh
+	checksum := d.Get("checksum").(string)
+	diags = append(diags, resourceWorkflowBundleRead(ctx, d, meta)...)
+	d.Set("checksum", checksum)
+	return diags
+}
+
+func resourceWorkflowBundleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// Only the objects created by the bundle are deleted, the workflow is deleted first as
+	// it references the tasks and option types
+	if d.Get("workflow_created").(bool) {
+		resp, err := client.DeleteTaskSet(toInt64(d.Id()), &morpheus.Request{})
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+	}
+
+	for _, taskId := range d.Get("created_task_ids").(*schema.Set).List() {
+		diags = append(diags, deleteWorkflowBundleObject(client, morpheus.TasksPath, "task", taskId.(int))...)
+	}
+	for _, optionTypeId := range d.Get("created_option_type_ids").(*schema.Set).List() {
+		diags = append(diags, deleteWorkflowBundleObject(client, morpheus.OptionTypesPath, "option type", optionTypeId.(int))...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId("")
+	return diags
+}
+
+// deleteWorkflowBundleObject deletes an object created by the bundle, an object Morpheus refuses
+// to delete, i.e. a task still used by a workflow outside of the bundle, is left in place
+func deleteWorkflowBundleObject(client *morpheus.Client, path string, objectName string, id int) diag.Diagnostics {
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("%s/%d", path, id),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		}
+		if resp != nil && (resp.StatusCode == 400 || resp.StatusCode == 409) {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("The %s %d created by the workflow bundle was left in place", objectName, id),
+				Detail:   fmt.Sprintf("Morpheus refused to delete the %s, i.e. because it is still used outside of the bundle: %s", objectName, err),
+			}}
+		}
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return nil
+}
+
+// workflowBundleCustomizeDiff sets the checksum of the bundle so the bundle is applied again when
+// it changes or its objects changed outside of Terraform, the ids of the bundle objects are marked
+// as unknown since objects can be added to, removed from or recreated by the bundle
+func workflowBundleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.NewValueKnown("bundle") {
+		checksum, err := workflowBundleChecksum(d.Get("bundle").(string))
+		if err != nil {
+			return err
+		}
+		if !d.HasChange("bundle") && d.Get("checksum").(string) == checksum {
+			return nil
+		}
+		if err := d.SetNew("checksum", checksum); err != nil {
+			return err
+		}
+	} else if err := d.SetNewComputed("checksum"); err != nil {
+		return err
+	}
+	for _, key := range []string{"task_ids", "option_type_ids", "created_task_ids", "created_option_type_ids", "workflow_name"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyWorkflowBundle creates or updates the option types, tasks and workflow of the
+// bundle in that order, the existing objects are matched by code
+func applyWorkflowBundle(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*morpheus.Client)
+
+	bundle, err := parseWorkflowBundle(d.Get("bundle").(string))
+	if err != nil {
+		return err
+	}
+
+	// The objects created by a previous apply remain owned by the bundle
+	previousOptionTypeIds, _ := d.GetChange("created_option_type_ids")
+	previousTaskIds, _ := d.GetChange("created_task_ids")
+
+	optionTypeIds := make(map[string]interface{})
+	var createdOptionTypeIds []interface{}
+	for _, optionType := range bundle.OptionTypes {
+		code := optionType["code"].(string)
+		id, created, err := upsertWorkflowBundleObject(client, morpheus.OptionTypesPath, "optionTypes", "optionType", "code", code, optionType)
+		if err != nil {
+			return fmt.Errorf("option type %s: %s", code, err)
+		}
+		optionTypeIds[code] = id
+		if created || previousOptionTypeIds.(*schema.Set).Contains(int(id)) {
+			createdOptionTypeIds = append(createdOptionTypeIds, int(id))
+		}
+	}
+	d.Set("option_type_ids", optionTypeIds)
+	d.Set("created_option_type_ids", createdOptionTypeIds)
+
+	taskIds := make(map[string]interface{})
+	var createdTaskIds []interface{}
+	for _, task := range bundle.Tasks {
+		code := task["code"].(string)
+		id, created, err := upsertWorkflowBundleObject(client, morpheus.TasksPath, "tasks", "task", "code", code, task)
+		if err != nil {
+			return fmt.Errorf("task %s: %s", code, err)
+		}
+		taskIds[code] = id
+		if created || previousTaskIds.(*schema.Set).Contains(int(id)) {
+			createdTaskIds = append(createdTaskIds, int(id))
+		}
+	}
+	d.Set("task_ids", taskIds)
+	d.Set("created_task_ids", createdTaskIds)
+
+	// Resolve the task and option type codes referenced by the workflow
+	workflow := workflowBundleObject(bundle.Workflow)
+	delete(workflow, "taskSetTasks")
+	tasks := make([]map[string]interface{}, 0)
+	for i, taskSetTask := range bundle.workflowTasks() {
+		code := workflowBundleReference(taskSetTask["task"])
+		if code == "" {
+			code, _ = taskSetTask["taskCode"].(string)
+		}
+		taskId, ok := taskIds[code]
+		if !ok {
+			taskId, err = findWorkflowBundleObject(client, morpheus.TasksPath, "tasks", "code", code)
+			if err != nil {
+				return fmt.Errorf("workflow task %s: %s", code, err)
+			}
+			if taskId == int64(0) {
+				return fmt.Errorf("workflow task %s: task not found in the bundle or in Morpheus", code)
+			}
+		}
+		row := map[string]interface{}{
+			"taskId":    taskId,
+			"taskPhase": taskSetTask["taskPhase"],
+			"taskOrder": i,
+		}
+		if row["taskPhase"] == nil {
+			row["taskPhase"] = "operation"
+		}
+		tasks = append(tasks, row)
+	}
+	workflow["tasks"] = tasks
+
+	optionTypes := make([]interface{}, 0)
+	if references, ok := bundle.Workflow["optionTypes"].([]interface{}); ok {
+		for _, reference := range references {
+			code := workflowBundleReference(reference)
+			optionTypeId, ok := optionTypeIds[code]
+			if !ok {
+				optionTypeId, err = findWorkflowBundleObject(client, morpheus.OptionTypesPath, "optionTypes", "code", code)
+				if err != nil {
+					return fmt.Errorf("workflow option type %s: %s", code, err)
+				}
+				if optionTypeId == int64(0) {
+					return fmt.Errorf("workflow option type %s: option type not found in the bundle or in Morpheus", code)
+				}
+			}
+			optionTypes = append(optionTypes, optionTypeId)
+		}
+	}
+	workflow["optionTypes"] = optionTypes
+
+	// Workflows are matched by code when the export includes one, otherwise by name
+	matchField := "code"
+	matchValue, _ := workflow["code"].(string)
+	if matchValue == "" {
+		matchField = "name"
+		matchValue, _ = workflow["name"].(string)
+	}
+	workflowId, created, err := upsertWorkflowBundleObject(client, morpheus.TaskSetsPath, "taskSets", "taskSet", matchField, matchValue, workflow)
+	if err != nil {
+		return fmt.Errorf("workflow %s: %s", matchValue, err)
+	}
+	if created || (d.Get("workflow_created").(bool) && int64ToString(workflowId) == d.Id()) {
+		d.Set("workflow_created", true)
+	} else {
+		d.Set("workflow_created", false)
+	}
+	d.SetId(int64ToString(workflowId))
+
+	checksum, err := workflowBundleChecksum(d.Get("bundle").(string))
+	if err != nil {
+		return err
+	}
+	d.Set("checksum", checksum)
+	return nil
+}
+
+// upsertWorkflowBundleObject updates the object with the matching field value or creates
+// it when it does not exist yet and returns its id and whether it was created
+func upsertWorkflowBundleObject(client *morpheus.Client, path string, listKey string, objectKey string, field string, value string, object map[string]interface{}) (int64, bool, error) {
+	id, err := findWorkflowBundleObject(client, path, listKey, field, value)
+	if err != nil {
+		return 0, false, err
+	}
+
+	payload := workflowBundleObject(object)
+	for key, value := range payload {
+		if payload[key], err = mapWorkflowBundleReferences(client, key, value); err != nil {
+			return 0, false, err
+		}
+	}
+
+	req := &morpheus.Request{
+		Method: "POST",
+		Path:   path,
+		Body: map[string]interface{}{
+			objectKey: payload,
+		},
+	}
+	if id != 0 {
+		req.Method = "PUT"
+		req.Path = fmt.Sprintf("%s/%d", path, id)
+	}
+	resp, err := client.Execute(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return 0, false, err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	if id != 0 {
+		return id, false, nil
+	}
+
+	var result map[string]WorkflowBundleObjectPayload
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return 0, false, err
+	}
+	if result[objectKey].ID == 0 {
+		return 0, false, fmt.Errorf("create operation: %s not found in response data", objectKey) // should not happen
+	}
+	return result[objectKey].ID, true, nil
+}
+
+// workflowBundleObjectChanged reports whether the object has been deleted or whether the
+// top level values of the exported object differ from the object in Morpheus. Nested
+// values are not compared as Morpheus expands and masks them in its responses
+func workflowBundleObjectChanged(client *morpheus.Client, path string, objectKey string, object map[string]interface{}) (bool, error) {
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   path,
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return true, nil
+		}
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return false, err
+	}
+
+	var result map[string]map[string]interface{}
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return false, err
+	}
+	current := result[objectKey]
+	for key, value := range workflowBundleObject(object) {
+		switch value.(type) {
+		case string, bool, float64:
+		default:
+			continue
+		}
+		// Values Morpheus does not return or returns as objects, i.e. a task type code, are skipped
+		switch currentValue := current[key].(type) {
+		case string, bool, float64:
+			if fmt.Sprint(value) != fmt.Sprint(currentValue) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// findWorkflowBundleObject returns the id of the object with the matching field value,
+// or 0 when there is none
+func findWorkflowBundleObject(client *morpheus.Client, path string, listKey string, field string, value string) (int64, error) {
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   path,
+		QueryParams: map[string]string{
+			field: value,
+			"max": "1000",
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return 0, err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var result map[string]json.RawMessage
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return 0, err
+	}
+	var objects []WorkflowBundleObjectPayload
+	if err := json.Unmarshal(result[listKey], &objects); err != nil {
+		return 0, err
+	}
+	for _, object := range objects {
+		if (field == "code" && object.Code == value) || (field == "name" && object.Name == value) {
+			return object.ID, nil
+		}
+	}
+	return 0, nil
+}
+
+// workflowBundleObject returns a copy of the exported object without the attributes
+// that are specific to the appliance it was exported from
+func workflowBundleObject(object map[string]interface{}) map[string]interface{} {
+	payload := make(map[string]interface{})
+	for key, value := range object {
+		switch key {
+		case "id", "uuid", "account", "accountId", "owner", "dateCreated", "lastUpdated":
+			continue
+		}
+		payload[key] = value
+	}
+	return payload
+}
+
+// workflowBundleLookups are the nested references to appliance specific objects without a code,
+// the references are mapped by name to the objects of the appliance the bundle is applied to
+var workflowBundleLookups = map[string]struct {
+	path    string
+	listKey string
+}{
+	"credential": {morpheus.CredentialsPath, "credentials"},
+	"repository": {morpheus.IntegrationsPath, "integrations"},
+	"optionList": {morpheus.OptionListsPath, "optionTypeLists"},
+}
+
+// mapWorkflowBundleReferences returns a copy of the nested value without the ids of the appliance
+// the bundle was exported from. References with a code, i.e. the task type, are sent by their code
+// and the references in workflowBundleLookups are looked up by their name
+func mapWorkflowBundleReferences(client *morpheus.Client, key string, value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case []interface{}:
+		items := make([]interface{}, 0, len(value))
+		for _, item := range value {
+			mapped, err := mapWorkflowBundleReferences(client, key, item)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, mapped)
+		}
+		return items, nil
+	case map[string]interface{}:
+		if lookup, ok := workflowBundleLookups[key]; ok && value["id"] != nil {
+			name, _ := value["name"].(string)
+			if name == "" {
+				return nil, fmt.Errorf("%s %v: the reference does not have a name", key, value["id"])
+			}
+			id, err := findWorkflowBundleObject(client, lookup.path, lookup.listKey, "name", name)
+			if err != nil {
+				return nil, err
+			}
+			if id == 0 {
+				return nil, fmt.Errorf("%s %s: not found in Morpheus", key, name)
+			}
+			return map[string]interface{}{"id": id}, nil
+		}
+		object := workflowBundleObject(value)
+		for nestedKey, nestedValue := range object {
+			mapped, err := mapWorkflowBundleReferences(client, nestedKey, nestedValue)
+			if err != nil {
+				return nil, err
+			}
+			object[nestedKey] = mapped
+		}
+		return object, nil
+	}
+	return value, nil
+}
+
+// workflowBundleStepsChanged reports whether the steps of the workflow in Morpheus differ from
+// the task codes and phases of the workflow of the bundle
+func workflowBundleStepsChanged(bundle *WorkflowBundlePayload, workflow *morpheus.TaskSet) bool {
+	steps := workflow.TaskSetTasks
+	sort.SliceStable(steps, func(i, j int) bool { return steps[i].TaskOrder < steps[j].TaskOrder })
+	references := bundle.workflowTasks()
+	if len(steps) != len(references) {
+		return true
+	}
+	for i, reference := range references {
+		code := workflowBundleReference(reference["task"])
+		if code == "" {
+			code, _ = reference["taskCode"].(string)
+		}
+		phase, _ := reference["taskPhase"].(string)
+		if phase == "" {
+			phase = "operation"
+		}
+		if steps[i].Task.Code != code || steps[i].TaskPhase != phase {
+			return true
+		}
+	}
+	return false
+}
+
+// workflowBundleChecksum returns the SHA-256 checksum of the bundle, the bundle is
+// normalized first so the formatting of the JSON document does not change the checksum
+func workflowBundleChecksum(document string) (string, error) {
+	var bundle interface{}
+	if err := json.Unmarshal([]byte(document), &bundle); err != nil {
+		return "", err
+	}
+	normalized, err := json.Marshal(bundle)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(normalized)), nil
+}
+
+// workflowBundleReference returns the code of an object reference which is either
+// the code itself or an object with a code
+func workflowBundleReference(reference interface{}) string {
+	switch value := reference.(type) {
+	case string:
+		return value
+	case map[string]interface{}:
+		code, _ := value["code"].(string)
+		return code
+	}
+	return ""
+}
+
+func parseWorkflowBundle(document string) (*WorkflowBundlePayload, error) {
+	var bundle WorkflowBundlePayload
+	if err := json.Unmarshal([]byte(document), &bundle); err != nil {
+		return nil, err
+	}
+	if bundle.Workflow == nil {
+		bundle.Workflow = bundle.TaskSet
+	}
+	if bundle.Workflow == nil {
+		return nil, fmt.Errorf("the bundle does not contain a workflow or taskSet object")
+	}
+	if name, _ := bundle.Workflow["name"].(string); name == "" {
+		return nil, fmt.Errorf("the workflow of the bundle does not have a name")
+	}
+	for i, optionType := range bundle.OptionTypes {
+		if code, _ := optionType["code"].(string); code == "" {
+			return nil, fmt.Errorf("option type %d of the bundle does not have a code", i+1)
+		}
+	}
+	for i, task := range bundle.Tasks {
+		if code, _ := task["code"].(string); code == "" {
+			return nil, fmt.Errorf("task %d of the bundle does not have a code", i+1)
+		}
+	}
+	for i, taskSetTask := range bundle.workflowTasks() {
+		code := workflowBundleReference(taskSetTask["task"])
+		if code == "" {
+			code, _ = taskSetTask["taskCode"].(string)
+		}
+		if code == "" {
+			return nil, fmt.Errorf("workflow task %d of the bundle does not reference a task code", i+1)
+		}
+	}
+	return &bundle, nil
+}
+
+func validateWorkflowBundle(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseWorkflowBundle(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid workflow bundle: %s", k, err))
+	}
+	return
+}
+
+type WorkflowBundlePayload struct {
+	OptionTypes []map[string]interface{} `json:"optionTypes"`
+	Tasks       []map[string]interface{} `json:"tasks"`
+	Workflow    map[string]interface{}   `json:"workflow"`
+	TaskSet     map[string]interface{}   `json:"taskSet"`
+}
+
+// workflowTasks returns the task references of the workflow sorted by their order
+func (bundle *WorkflowBundlePayload) workflowTasks() []map[string]interface{} {
+	references, _ := bundle.Workflow["taskSetTasks"].([]interface{})
+	tasks := make([]map[string]interface{}, 0)
+	for _, reference := range references {
+		if task, ok := reference.(map[string]interface{}); ok {
+			tasks = append(tasks, task)
+		}
+	}
+	taskOrder := func(task map[string]interface{}) float64 {
+		switch value := task["taskOrder"].(type) {
+		case float64:
+			return value
+		case string:
+			order, _ := strconv.ParseFloat(value, 64)
+			return order
+		}
+		return 0
+	}
+	sort.SliceStable(tasks, func(i, j int) bool { return taskOrder(tasks[i]) < taskOrder(tasks[j]) })
+	return tasks
+}
+
+type WorkflowBundleObjectPayload struct {
+	ID   int64  `json:"id"`
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
@@ -21,6 +21,7 @@ func resourceWorkflowCatalogItem() *schema.Resource {
 		ReadContext:   resourceWorkflowCatalogItemRead,
 		UpdateContext: resourceWorkflowCatalogItemUpdate,
 		DeleteContext: resourceWorkflowCatalogItemDelete,
+		CustomizeDiff: catalogItemOptionTypesCustomizeDiff("content"),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
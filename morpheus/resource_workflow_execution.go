@@ -0,0 +1,37 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWorkflowExecution() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus workflow execution resource, the workflow is executed when the resource is created and any time the triggers change",
+		CreateContext: resourceWorkflowExecutionCreate,
+		ReadContext:   resourceJobExecutionRead,
+		DeleteContext: resourceJobExecutionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: jobExecutionSchema(map[string]*schema.Schema{
+			"workflow_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the workflow to execute",
+				Required:    true,
+				ForceNew:    true,
+			},
+		}),
+	}
+}
+
+func resourceWorkflowExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	workflowId := d.Get("workflow_id").(int)
+	return executeJob(ctx, d, meta, fmt.Sprintf("%s/%d/execute", morpheus.TaskSetsPath, workflowId))
+}
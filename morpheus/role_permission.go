@@ -0,0 +1,150 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// rolePermissionType describes the access a role grants to a single feature, cloud,
+// group or catalog item type, the role permission resources only differ by their type
+type rolePermissionType struct {
+	// name is the name of the permission used in the logs
+	name string
+	// attribute is the attribute holding the code or id of the object the access is granted to
+	attribute string
+	// bodyKey is the key of the code or id of the object in the update request
+	bodyKey string
+	// numeric is set when the object is identified by its id rather than its code
+	numeric bool
+	// revokedAccess is the access the permission is reset to when the resource is destroyed
+	revokedAccess string
+	// update is the SDK call updating the access of the role
+	update func(client *morpheus.Client, roleId int64, req *morpheus.Request) (*morpheus.Response, error)
+	// permissions lists the permissions of the type granted by the role
+	permissions func(result *morpheus.GetRoleResult) []rolePermission
+}
+
+// rolePermission is the access a role grants to a single object
+type rolePermission struct {
+	ID     string
+	Name   string
+	Access string
+}
+
+// objectId returns the code or id of the object in the form the API expects
+func (t rolePermissionType) objectId(id string) interface{} {
+	if t.numeric {
+		return toInt64(id)
+	}
+	return id
+}
+
+func (t rolePermissionType) createContext() schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*morpheus.Client)
+
+		// Warning or errors can be collected in a slice type
+		var diags diag.Diagnostics
+
+		id := fmt.Sprint(d.Get(t.attribute))
+		if err := t.updateAccess(client, int64(d.Get("role_id").(int)), id, d.Get("access").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Successfully granted the permission, now set id
+		d.SetId(id)
+
+		t.readContext()(ctx, d, meta)
+		return diags
+	}
+}
+
+func (t rolePermissionType) readContext() schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*morpheus.Client)
+
+		// Warning or errors can be collected in a slice type
+		var diags diag.Diagnostics
+
+		id := d.Id()
+		roleId := int64(d.Get("role_id").(int))
+
+		resp, err := client.GetRole(roleId, &morpheus.Request{})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("API 404: %s - %s", resp, err)
+				log.Printf("Forcing recreation of resource")
+				d.SetId("")
+				return diags
+			} else {
+				log.Printf("API FAILURE: %s - %s", resp, err)
+				return diag.FromErr(err)
+			}
+		}
+		log.Printf("API RESPONSE: %s", resp)
+
+		// store resource data
+		result := resp.Result.(*morpheus.GetRoleResult)
+		for _, permission := range t.permissions(result) {
+			if permission.ID == id {
+				d.Set(t.attribute, t.objectId(permission.ID))
+				d.Set("name", permission.Name)
+				d.Set("access", permission.Access)
+				return diags
+			}
+		}
+
+		log.Printf("%s permission %s not found on role %d, forcing recreation of resource", t.name, id, roleId)
+		d.SetId("")
+		return diags
+	}
+}
+
+func (t rolePermissionType) updateContext() schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*morpheus.Client)
+
+		if err := t.updateAccess(client, int64(d.Get("role_id").(int)), d.Id(), d.Get("access").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+		return t.readContext()(ctx, d, meta)
+	}
+}
+
+func (t rolePermissionType) deleteContext() schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*morpheus.Client)
+
+		// Warning or errors can be collected in a slice type
+		var diags diag.Diagnostics
+
+		// The object can not be removed from the role, reset the access instead
+		if err := t.updateAccess(client, int64(d.Get("role_id").(int)), d.Id(), t.revokedAccess); err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId("")
+		return diags
+	}
+}
+
+func (t rolePermissionType) updateAccess(client *morpheus.Client, roleId int64, id string, access string) error {
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			t.bodyKey: t.objectId(id),
+			"access":  access,
+		},
+	}
+	resp, err := t.update(client, roleId, req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return nil
+}
@@ -1,8 +1,14 @@
 package morpheus
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func jsonBytesEqual(b1, b2 []byte) bool {
@@ -42,3 +48,21 @@ func parseEnvironmentVariables(variables []interface{}) []map[string]interface{}
 	}
 	return evars
 }
+
+// importNestedResourceState returns an import function for resources that are
+// nested under a parent object, with the import id in the <parent id>:<id> format
+func importNestedResourceState(parentAttribute string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.SplitN(d.Id(), ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("unexpected format of import id (%s), expected <%s>:<id>", d.Id(), parentAttribute)
+		}
+		parentId, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s in import id (%s): %s", parentAttribute, d.Id(), err)
+		}
+		d.Set(parentAttribute, parentId)
+		d.SetId(parts[1])
+		return []*schema.ResourceData{d}, nil
+	}
+}